@@ -7,6 +7,7 @@
 package v1
 
 import (
+	v1 "eventservice/api/money/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -22,16 +23,411 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// ---------------- Messages ----------------
+// BookingStatus is the lifecycle state of a booking. Allowed moves:
+// PENDING -> CONFIRMED | CANCELLED | EXPIRED, CONFIRMED -> CANCELLED | REFUNDED.
+type BookingStatus int32
+
+const (
+	BookingStatus_BOOKING_STATUS_UNSPECIFIED BookingStatus = 0
+	BookingStatus_PENDING                    BookingStatus = 1
+	BookingStatus_CONFIRMED                  BookingStatus = 2
+	BookingStatus_CANCELLED                  BookingStatus = 3
+	BookingStatus_EXPIRED                    BookingStatus = 4
+	BookingStatus_REFUNDED                   BookingStatus = 5
+)
+
+// Enum value maps for BookingStatus.
+var (
+	BookingStatus_name = map[int32]string{
+		0: "BOOKING_STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "CONFIRMED",
+		3: "CANCELLED",
+		4: "EXPIRED",
+		5: "REFUNDED",
+	}
+	BookingStatus_value = map[string]int32{
+		"BOOKING_STATUS_UNSPECIFIED": 0,
+		"PENDING":                    1,
+		"CONFIRMED":                  2,
+		"CANCELLED":                  3,
+		"EXPIRED":                    4,
+		"REFUNDED":                   5,
+	}
+)
+
+func (x BookingStatus) Enum() *BookingStatus {
+	p := new(BookingStatus)
+	*p = x
+	return p
+}
+
+func (x BookingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[0].Descriptor()
+}
+
+func (BookingStatus) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[0]
+}
+
+func (x BookingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingStatus.Descriptor instead.
+func (BookingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{0}
+}
+
+// BookingActor is who moved a booking from one status to another.
+type BookingActor int32
+
+const (
+	BookingActor_BOOKING_ACTOR_UNSPECIFIED BookingActor = 0
+	BookingActor_ACTOR_USER                BookingActor = 1 // the booking's user, or someone acting for them
+	BookingActor_ACTOR_PAYMENT             BookingActor = 2 // the payment service, after a payment succeeded or failed
+	BookingActor_ACTOR_SYSTEM              BookingActor = 3 // a background job, such as the PENDING expiry sweep
+)
+
+// Enum value maps for BookingActor.
+var (
+	BookingActor_name = map[int32]string{
+		0: "BOOKING_ACTOR_UNSPECIFIED",
+		1: "ACTOR_USER",
+		2: "ACTOR_PAYMENT",
+		3: "ACTOR_SYSTEM",
+	}
+	BookingActor_value = map[string]int32{
+		"BOOKING_ACTOR_UNSPECIFIED": 0,
+		"ACTOR_USER":                1,
+		"ACTOR_PAYMENT":             2,
+		"ACTOR_SYSTEM":              3,
+	}
+)
+
+func (x BookingActor) Enum() *BookingActor {
+	p := new(BookingActor)
+	*p = x
+	return p
+}
+
+func (x BookingActor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingActor) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[1].Descriptor()
+}
+
+func (BookingActor) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[1]
+}
+
+func (x BookingActor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingActor.Descriptor instead.
+func (BookingActor) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{1}
+}
+
+// BookingSort orders a booking list. Ties are broken by booking id.
+type BookingSort int32
+
+const (
+	BookingSort_CREATED_AT_DESC BookingSort = 0 // newest first
+	BookingSort_CREATED_AT_ASC  BookingSort = 1
+	BookingSort_TOTAL_COST_DESC BookingSort = 2
+	BookingSort_TOTAL_COST_ASC  BookingSort = 3
+)
+
+// Enum value maps for BookingSort.
+var (
+	BookingSort_name = map[int32]string{
+		0: "CREATED_AT_DESC",
+		1: "CREATED_AT_ASC",
+		2: "TOTAL_COST_DESC",
+		3: "TOTAL_COST_ASC",
+	}
+	BookingSort_value = map[string]int32{
+		"CREATED_AT_DESC": 0,
+		"CREATED_AT_ASC":  1,
+		"TOTAL_COST_DESC": 2,
+		"TOTAL_COST_ASC":  3,
+	}
+)
+
+func (x BookingSort) Enum() *BookingSort {
+	p := new(BookingSort)
+	*p = x
+	return p
+}
+
+func (x BookingSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[2].Descriptor()
+}
+
+func (BookingSort) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[2]
+}
+
+func (x BookingSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingSort.Descriptor instead.
+func (BookingSort) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{2}
+}
+
+// WaitlistState is the lifecycle state of a waitlist entry. Allowed moves:
+// WAITING -> OFFERED | LEFT, OFFERED -> ACCEPTED | EXPIRED | LEFT.
+type WaitlistState int32
+
+const (
+	WaitlistState_WAITLIST_STATE_UNSPECIFIED WaitlistState = 0
+	WaitlistState_WAITLIST_WAITING           WaitlistState = 1
+	WaitlistState_WAITLIST_OFFERED           WaitlistState = 2
+	WaitlistState_WAITLIST_ACCEPTED          WaitlistState = 3
+	WaitlistState_WAITLIST_EXPIRED           WaitlistState = 4
+	WaitlistState_WAITLIST_LEFT              WaitlistState = 5
+)
+
+// Enum value maps for WaitlistState.
+var (
+	WaitlistState_name = map[int32]string{
+		0: "WAITLIST_STATE_UNSPECIFIED",
+		1: "WAITLIST_WAITING",
+		2: "WAITLIST_OFFERED",
+		3: "WAITLIST_ACCEPTED",
+		4: "WAITLIST_EXPIRED",
+		5: "WAITLIST_LEFT",
+	}
+	WaitlistState_value = map[string]int32{
+		"WAITLIST_STATE_UNSPECIFIED": 0,
+		"WAITLIST_WAITING":           1,
+		"WAITLIST_OFFERED":           2,
+		"WAITLIST_ACCEPTED":          3,
+		"WAITLIST_EXPIRED":           4,
+		"WAITLIST_LEFT":              5,
+	}
+)
+
+func (x WaitlistState) Enum() *WaitlistState {
+	p := new(WaitlistState)
+	*p = x
+	return p
+}
+
+func (x WaitlistState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistState) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[3].Descriptor()
+}
+
+func (WaitlistState) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[3]
+}
+
+func (x WaitlistState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistState.Descriptor instead.
+func (WaitlistState) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{3}
+}
+
+// TransferState is the lifecycle state of a booking transfer. Allowed moves:
+// PENDING -> ACCEPTED | CANCELLED | EXPIRED.
+type TransferState int32
+
+const (
+	TransferState_TRANSFER_STATE_UNSPECIFIED TransferState = 0
+	TransferState_TRANSFER_PENDING           TransferState = 1
+	TransferState_TRANSFER_ACCEPTED          TransferState = 2
+	TransferState_TRANSFER_CANCELLED         TransferState = 3
+	TransferState_TRANSFER_EXPIRED           TransferState = 4
+)
+
+// Enum value maps for TransferState.
+var (
+	TransferState_name = map[int32]string{
+		0: "TRANSFER_STATE_UNSPECIFIED",
+		1: "TRANSFER_PENDING",
+		2: "TRANSFER_ACCEPTED",
+		3: "TRANSFER_CANCELLED",
+		4: "TRANSFER_EXPIRED",
+	}
+	TransferState_value = map[string]int32{
+		"TRANSFER_STATE_UNSPECIFIED": 0,
+		"TRANSFER_PENDING":           1,
+		"TRANSFER_ACCEPTED":          2,
+		"TRANSFER_CANCELLED":         3,
+		"TRANSFER_EXPIRED":           4,
+	}
+)
+
+func (x TransferState) Enum() *TransferState {
+	p := new(TransferState)
+	*p = x
+	return p
+}
+
+func (x TransferState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferState) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[4].Descriptor()
+}
+
+func (TransferState) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[4]
+}
+
+func (x TransferState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferState.Descriptor instead.
+func (TransferState) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{4}
+}
+
+// TicketStatus is the state of a seat ticket. A VALID ticket becomes VOID
+// when its seat leaves the booking, the booking is cancelled or refunded, or
+// the booking changes owner.
+type TicketStatus int32
+
+const (
+	TicketStatus_TICKET_STATUS_UNSPECIFIED TicketStatus = 0
+	TicketStatus_TICKET_VALID              TicketStatus = 1
+	TicketStatus_TICKET_VOID               TicketStatus = 2
+)
+
+// Enum value maps for TicketStatus.
+var (
+	TicketStatus_name = map[int32]string{
+		0: "TICKET_STATUS_UNSPECIFIED",
+		1: "TICKET_VALID",
+		2: "TICKET_VOID",
+	}
+	TicketStatus_value = map[string]int32{
+		"TICKET_STATUS_UNSPECIFIED": 0,
+		"TICKET_VALID":              1,
+		"TICKET_VOID":               2,
+	}
+)
+
+func (x TicketStatus) Enum() *TicketStatus {
+	p := new(TicketStatus)
+	*p = x
+	return p
+}
+
+func (x TicketStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[5].Descriptor()
+}
+
+func (TicketStatus) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[5]
+}
+
+func (x TicketStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketStatus.Descriptor instead.
+func (TicketStatus) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{5}
+}
+
+// SeatUpdateKind is what happened to the seats of a SeatUpdate. Holds and
+// bookings are tracked apart, as GetLockedSeats and GetBookedSeats do.
+type SeatUpdateKind int32
+
+const (
+	SeatUpdateKind_SEAT_UPDATE_KIND_UNSPECIFIED SeatUpdateKind = 0
+	SeatUpdateKind_SEAT_HELD                    SeatUpdateKind = 1 // held, or a hold was extended, until expires_at
+	SeatUpdateKind_SEAT_RELEASED                SeatUpdateKind = 2 // a hold was released before it expired; the seat may still be booked
+	SeatUpdateKind_SEAT_BOOKED                  SeatUpdateKind = 3 // taken by a CONFIRMED booking
+	SeatUpdateKind_SEAT_CANCELLED               SeatUpdateKind = 4 // given back by a CONFIRMED booking
+)
+
+// Enum value maps for SeatUpdateKind.
+var (
+	SeatUpdateKind_name = map[int32]string{
+		0: "SEAT_UPDATE_KIND_UNSPECIFIED",
+		1: "SEAT_HELD",
+		2: "SEAT_RELEASED",
+		3: "SEAT_BOOKED",
+		4: "SEAT_CANCELLED",
+	}
+	SeatUpdateKind_value = map[string]int32{
+		"SEAT_UPDATE_KIND_UNSPECIFIED": 0,
+		"SEAT_HELD":                    1,
+		"SEAT_RELEASED":                2,
+		"SEAT_BOOKED":                  3,
+		"SEAT_CANCELLED":               4,
+	}
+)
+
+func (x SeatUpdateKind) Enum() *SeatUpdateKind {
+	p := new(SeatUpdateKind)
+	*p = x
+	return p
+}
+
+func (x SeatUpdateKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatUpdateKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_booking_proto_enumTypes[6].Descriptor()
+}
+
+func (SeatUpdateKind) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_booking_proto_enumTypes[6]
+}
+
+func (x SeatUpdateKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatUpdateKind.Descriptor instead.
+func (SeatUpdateKind) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{6}
+}
+
 type Booking struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	SeatIds       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	TotalCost     float32                `protobuf:"fixed32,7,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	TicketCode    string                 `protobuf:"bytes,8,opt,name=ticket_code,json=ticketCode,proto3" json:"ticket_code,omitempty"` // changes when the booking is transferred
+	TotalCost     *v1.Money              `protobuf:"bytes,9,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`    // what the booking costs after any discount
+	PromoCode     string                 `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`   // promo code the booking was made with, if any
+	Discount      *v1.Money              `protobuf:"bytes,11,opt,name=discount,proto3" json:"discount,omitempty"`                      // what the promo code took off
+	History       []*BookingStatusChange `protobuf:"bytes,12,rep,name=history,proto3" json:"history,omitempty"`                        // oldest first; only set by GetBooking with include_history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -94,11 +490,11 @@ func (x *Booking) GetSeatIds() []string {
 	return nil
 }
 
-func (x *Booking) GetStatus() string {
+func (x *Booking) GetStatus() BookingStatus {
 	if x != nil {
 		return x.Status
 	}
-	return ""
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
 }
 
 func (x *Booking) GetCreatedAt() string {
@@ -108,20 +504,54 @@ func (x *Booking) GetCreatedAt() string {
 	return ""
 }
 
-func (x *Booking) GetTotalCost() float32 {
+func (x *Booking) GetTicketCode() string {
+	if x != nil {
+		return x.TicketCode
+	}
+	return ""
+}
+
+func (x *Booking) GetTotalCost() *v1.Money {
 	if x != nil {
 		return x.TotalCost
 	}
-	return 0
+	return nil
+}
+
+func (x *Booking) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Booking) GetDiscount() *v1.Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *Booking) GetHistory() []*BookingStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
 }
 
 type CreateBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId        uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds        []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	HoldToken      string                 `protobuf:"bytes,4,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`                // token from LockSeat; when set, every seat must be held under it
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // client-chosen key; a retry with the same key returns the first booking (the Idempotency-Key header works too)
+	// Optional; its discount comes off the total. Needs the user's bearer
+	// token, as the code's per-user limit counts the token's user.
+	PromoCode      string `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	AdmissionToken string `protobuf:"bytes,7,opt,name=admission_token,json=admissionToken,proto3" json:"admission_token,omitempty"` // from GetQueueStatus; needed when the event has a waiting room
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateBookingRequest) Reset() {
@@ -175,6 +605,34 @@ func (x *CreateBookingRequest) GetSeatIds() []string {
 	return nil
 }
 
+func (x *CreateBookingRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetAdmissionToken() string {
+	if x != nil {
+		return x.AdmissionToken
+	}
+	return ""
+}
+
 type CreateBookingReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -220,27 +678,1972 @@ func (x *CreateBookingReply) GetBooking() *Booking {
 }
 
 type GetBookingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeHistory bool                   `protobuf:"varint,2,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"` // also return the booking's status history; needs the owner's or a staff bearer token
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBookingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetBookingRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+// BookingStatusChange is one entry of a booking's status history. The first
+// entry of every booking is its creation, from BOOKING_STATUS_UNSPECIFIED.
+type BookingStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     uint64                 `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	FromStatus    BookingStatus          `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=booking.v1.BookingStatus" json:"from_status,omitempty"`
+	ToStatus      BookingStatus          `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=booking.v1.BookingStatus" json:"to_status,omitempty"`
+	Actor         BookingActor           `protobuf:"varint,5,opt,name=actor,proto3,enum=booking.v1.BookingActor" json:"actor,omitempty"`
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // user id, payment id or job name, if known
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     string                 `protobuf:"bytes,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"` // RFC3339
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookingRequest) Reset() {
-	*x = GetBookingRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[3]
+func (x *BookingStatusChange) Reset() {
+	*x = BookingStatusChange{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingStatusChange) ProtoMessage() {}
+
+func (x *BookingStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingStatusChange.ProtoReflect.Descriptor instead.
+func (*BookingStatusChange) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingStatusChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BookingStatusChange) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *BookingStatusChange) GetFromStatus() BookingStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetToStatus() BookingStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetActor() BookingActor {
+	if x != nil {
+		return x.Actor
+	}
+	return BookingActor_BOOKING_ACTOR_UNSPECIFIED
+}
+
+func (x *BookingStatusChange) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BookingStatusChange) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBookingHistoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetBookingHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*BookingStatusChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryReply) Reset() {
+	*x = GetBookingHistoryReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryReply) ProtoMessage() {}
+
+func (x *GetBookingHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryReply.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBookingHistoryReply) GetChanges() []*BookingStatusChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	CreatedAfter  string                 `protobuf:"bytes,4,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // RFC3339, inclusive
+	CreatedBefore string                 `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // RFC3339, exclusive
+	Sort          BookingSort            `protobuf:"varint,6,opt,name=sort,proto3,enum=booking.v1.BookingSort" json:"sort,omitempty"`
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default 20, at most 100
+	PageToken     string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListBookingsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListBookingsRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ListBookingsRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *ListBookingsRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetSort() BookingSort {
+	if x != nil {
+		return x.Sort
+	}
+	return BookingSort_CREATED_AT_DESC
+}
+
+func (x *ListBookingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListBookingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMyBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	CreatedAfter  string                 `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`    // RFC3339, inclusive
+	CreatedBefore string                 `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"` // RFC3339, exclusive
+	Sort          BookingSort            `protobuf:"varint,5,opt,name=sort,proto3,enum=booking.v1.BookingSort" json:"sort,omitempty"`
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // default 20, at most 100
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMyBookingsRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ListMyBookingsRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *ListMyBookingsRequest) GetCreatedAfter() string {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return ""
+}
+
+func (x *ListMyBookingsRequest) GetCreatedBefore() string {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return ""
+}
+
+func (x *ListMyBookingsRequest) GetSort() BookingSort {
+	if x != nil {
+		return x.Sort
+	}
+	return BookingSort_CREATED_AT_DESC
+}
+
+func (x *ListMyBookingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyBookingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListBookingsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsReply) Reset() {
+	*x = ListBookingsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsReply) ProtoMessage() {}
+
+func (x *ListBookingsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsReply.ProtoReflect.Descriptor instead.
+func (*ListBookingsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBookingsReply) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListBookingsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // recorded in the status history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBookingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // seats to drop; at least one seat must be kept
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSeatsRequest) Reset() {
+	*x = CancelSeatsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeatsRequest) ProtoMessage() {}
+
+func (x *CancelSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeatsRequest.ProtoReflect.Descriptor instead.
+func (*CancelSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelSeatsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type CancelSeatsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	Refund        *v1.Money              `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"` // refund requested from paymentservice; 0 for a PENDING booking
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSeatsReply) Reset() {
+	*x = CancelSeatsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeatsReply) ProtoMessage() {}
+
+func (x *CancelSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeatsReply.ProtoReflect.Descriptor instead.
+func (*CancelSeatsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelSeatsReply) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *CancelSeatsReply) GetRefund() *v1.Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type ChangeSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OldSeatIds    []string               `protobuf:"bytes,3,rep,name=old_seat_ids,json=oldSeatIds,proto3" json:"old_seat_ids,omitempty"` // seats to give up
+	NewSeatIds    []string               `protobuf:"bytes,4,rep,name=new_seat_ids,json=newSeatIds,proto3" json:"new_seat_ids,omitempty"` // seats to take instead; the counts may differ
+	HoldToken     string                 `protobuf:"bytes,5,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`      // optional; a hold on the new seats from LockSeat
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSeatsRequest) Reset() {
+	*x = ChangeSeatsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatsRequest) ProtoMessage() {}
+
+func (x *ChangeSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeSeatsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeSeatsRequest) GetOldSeatIds() []string {
+	if x != nil {
+		return x.OldSeatIds
+	}
+	return nil
+}
+
+func (x *ChangeSeatsRequest) GetNewSeatIds() []string {
+	if x != nil {
+		return x.NewSeatIds
+	}
+	return nil
+}
+
+func (x *ChangeSeatsRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type ChangeSeatsReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Booking         *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	PriceDifference *v1.Money              `protobuf:"bytes,3,opt,name=price_difference,json=priceDifference,proto3" json:"price_difference,omitempty"` // new seats' price less the old seats' share; charged when positive and refunded when negative for a CONFIRMED booking
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeSeatsReply) Reset() {
+	*x = ChangeSeatsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSeatsReply) ProtoMessage() {}
+
+func (x *ChangeSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSeatsReply.ProtoReflect.Descriptor instead.
+func (*ChangeSeatsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChangeSeatsReply) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+func (x *ChangeSeatsReply) GetPriceDifference() *v1.Money {
+	if x != nil {
+		return x.PriceDifference
+	}
+	return nil
+}
+
+type ConfirmBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConfirmBookingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ConfirmBookingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingReply) Reset() {
+	*x = ConfirmBookingReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingReply) ProtoMessage() {}
+
+func (x *ConfirmBookingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingReply.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmBookingReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateBookingRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status BookingStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=booking.v1.BookingStatus" json:"status,omitempty"`
+	// The settled payment and why it moves the booking, recorded in the
+	// status history as an ACTOR_PAYMENT change.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	PaymentId     uint64 `protobuf:"varint,6,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingRequest) Reset() {
+	*x = UpdateBookingRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingRequest) ProtoMessage() {}
+
+func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateBookingRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBookingRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateBookingRequest) GetPaymentId() uint64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type UpdateBookingReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingReply) Reset() {
+	*x = UpdateBookingReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingReply) ProtoMessage() {}
+
+func (x *UpdateBookingReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingReply.ProtoReflect.Descriptor instead.
+func (*UpdateBookingReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateBookingReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLockedSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // caller, used to fill LockedSeat.owned_by_caller
+	HoldToken     string                 `protobuf:"bytes,3,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"` // optional; narrows ownership to one hold
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockedSeatsRequest) Reset() {
+	*x = GetLockedSeatsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockedSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockedSeatsRequest) ProtoMessage() {}
+
+func (x *GetLockedSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockedSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLockedSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetLockedSeatsRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *GetLockedSeatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetLockedSeatsRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type LockedSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatId        string                 `protobuf:"bytes,1,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, millisecond precision
+	OwnedByCaller bool                   `protobuf:"varint,3,opt,name=owned_by_caller,json=ownedByCaller,proto3" json:"owned_by_caller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockedSeat) Reset() {
+	*x = LockedSeat{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockedSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedSeat) ProtoMessage() {}
+
+func (x *LockedSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockedSeat.ProtoReflect.Descriptor instead.
+func (*LockedSeat) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LockedSeat) GetSeatId() string {
+	if x != nil {
+		return x.SeatId
+	}
+	return ""
+}
+
+func (x *LockedSeat) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *LockedSeat) GetOwnedByCaller() bool {
+	if x != nil {
+		return x.OwnedByCaller
+	}
+	return false
+}
+
+type GetLockedSeatsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Seats         []*LockedSeat          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockedSeatsReply) Reset() {
+	*x = GetLockedSeatsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockedSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockedSeatsReply) ProtoMessage() {}
+
+func (x *GetLockedSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockedSeatsReply.ProtoReflect.Descriptor instead.
+func (*GetLockedSeatsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLockedSeatsReply) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *GetLockedSeatsReply) GetSeats() []*LockedSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type LockSeatRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds        []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	UserId         uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AdmissionToken string                 `protobuf:"bytes,4,opt,name=admission_token,json=admissionToken,proto3" json:"admission_token,omitempty"` // from GetQueueStatus; needed when the event has a waiting room
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LockSeatRequest) Reset() {
+	*x = LockSeatRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockSeatRequest) ProtoMessage() {}
+
+func (x *LockSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockSeatRequest.ProtoReflect.Descriptor instead.
+func (*LockSeatRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LockSeatRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *LockSeatRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *LockSeatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LockSeatRequest) GetAdmissionToken() string {
+	if x != nil {
+		return x.AdmissionToken
+	}
+	return ""
+}
+
+type LockSeatReply struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Locked             bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	ConflictingSeatIds []string               `protobuf:"bytes,2,rep,name=conflicting_seat_ids,json=conflictingSeatIds,proto3" json:"conflicting_seat_ids,omitempty"` // seats held by someone else when locked is false
+	HoldToken          string                 `protobuf:"bytes,3,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`                              // pass to UnlockSeat / CreateBooking to prove ownership
+	ExpiresAt          string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                              // RFC3339, millisecond precision
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LockSeatReply) Reset() {
+	*x = LockSeatReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockSeatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockSeatReply) ProtoMessage() {}
+
+func (x *LockSeatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockSeatReply.ProtoReflect.Descriptor instead.
+func (*LockSeatReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LockSeatReply) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *LockSeatReply) GetConflictingSeatIds() []string {
+	if x != nil {
+		return x.ConflictingSeatIds
+	}
+	return nil
+}
+
+func (x *LockSeatReply) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+func (x *LockSeatReply) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type UnlockSeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldToken     string                 `protobuf:"bytes,4,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockSeatRequest) Reset() {
+	*x = UnlockSeatRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSeatRequest) ProtoMessage() {}
+
+func (x *UnlockSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSeatRequest.ProtoReflect.Descriptor instead.
+func (*UnlockSeatRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnlockSeatRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *UnlockSeatRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *UnlockSeatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnlockSeatRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type UnlockSeatReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockSeatReply) Reset() {
+	*x = UnlockSeatReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockSeatReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockSeatReply) ProtoMessage() {}
+
+func (x *UnlockSeatReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockSeatReply.ProtoReflect.Descriptor instead.
+func (*UnlockSeatReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnlockSeatReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ExtendSeatHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HoldToken     string                 `protobuf:"bytes,4,opt,name=hold_token,json=holdToken,proto3" json:"hold_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendSeatHoldRequest) Reset() {
+	*x = ExtendSeatHoldRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendSeatHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendSeatHoldRequest) ProtoMessage() {}
+
+func (x *ExtendSeatHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendSeatHoldRequest.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ExtendSeatHoldRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *ExtendSeatHoldRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ExtendSeatHoldRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExtendSeatHoldRequest) GetHoldToken() string {
+	if x != nil {
+		return x.HoldToken
+	}
+	return ""
+}
+
+type ExtendSeatHoldReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresAt      string                 `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, millisecond precision
+	ExtensionsLeft int32                  `protobuf:"varint,2,opt,name=extensions_left,json=extensionsLeft,proto3" json:"extensions_left,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExtendSeatHoldReply) Reset() {
+	*x = ExtendSeatHoldReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendSeatHoldReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendSeatHoldReply) ProtoMessage() {}
+
+func (x *ExtendSeatHoldReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendSeatHoldReply.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExtendSeatHoldReply) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ExtendSeatHoldReply) GetExtensionsLeft() int32 {
+	if x != nil {
+		return x.ExtensionsLeft
+	}
+	return 0
+}
+
+type GetBookedSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookedSeatsRequest) Reset() {
+	*x = GetBookedSeatsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookedSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookedSeatsRequest) ProtoMessage() {}
+
+func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookedSeatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBookedSeatsRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+type GetBookedSeatsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookedSeatsReply) Reset() {
+	*x = GetBookedSeatsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookedSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookedSeatsReply) ProtoMessage() {}
+
+func (x *GetBookedSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookedSeatsReply.ProtoReflect.Descriptor instead.
+func (*GetBookedSeatsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBookedSeatsReply) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type WaitlistEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId        uint64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId         uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SeatCount      int32                  `protobuf:"varint,4,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`
+	State          WaitlistState          `protobuf:"varint,5,opt,name=state,proto3,enum=booking.v1.WaitlistState" json:"state,omitempty"`
+	Position       int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`                                    // 1-based place in the queue while WAITING
+	OfferExpiresAt string                 `protobuf:"bytes,7,opt,name=offer_expires_at,json=offerExpiresAt,proto3" json:"offer_expires_at,omitempty"` // RFC3339, set while OFFERED
+	BookingId      uint64                 `protobuf:"varint,8,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`                 // set once ACCEPTED
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WaitlistEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetState() WaitlistState {
+	if x != nil {
+		return x.State
+	}
+	return WaitlistState_WAITLIST_STATE_UNSPECIFIED
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetOfferExpiresAt() string {
+	if x != nil {
+		return x.OfferExpiresAt
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type JoinWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatCount     int32                  `protobuf:"varint,3,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *JoinWaitlistRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+type GetWaitlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistEntryRequest) Reset() {
+	*x = GetWaitlistEntryRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *GetWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWaitlistEntryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LeaveWaitlistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AcceptWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"` // at most the entry's seat_count
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitlistOfferRequest) Reset() {
+	*x = AcceptWaitlistOfferRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AcceptWaitlistOfferRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcceptWaitlistOfferRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+type WaitlistEntryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntryReply) Reset() {
+	*x = WaitlistEntryReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntryReply) ProtoMessage() {}
+
+func (x *WaitlistEntryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntryReply.ProtoReflect.Descriptor instead.
+func (*WaitlistEntryReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WaitlistEntryReply) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type Transfer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId      uint64                 `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	FromUserId     uint64                 `protobuf:"varint,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId       uint64                 `protobuf:"varint,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	RecipientEmail string                 `protobuf:"bytes,5,opt,name=recipient_email,json=recipientEmail,proto3" json:"recipient_email,omitempty"`
+	State          TransferState          `protobuf:"varint,6,opt,name=state,proto3,enum=booking.v1.TransferState" json:"state,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // RFC3339
+	ExpiresAt      string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`      // RFC3339; a PENDING transfer lapses after this
+	ResolvedAt     string                 `protobuf:"bytes,9,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`   // RFC3339, when it left PENDING
+	ResolvedBy     uint64                 `protobuf:"varint,10,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"` // user who accepted or cancelled it
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingRequest) String() string {
+func (x *Transfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingRequest) ProtoMessage() {}
+func (*Transfer) ProtoMessage() {}
 
-func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[3]
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,76 +2654,104 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetBookingRequest) GetId() uint64 {
+func (x *Transfer) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Transfer) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
 }
 
-func (x *ListBookingsRequest) Reset() {
-	*x = ListBookingsRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Transfer) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
 }
 
-func (x *ListBookingsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Transfer) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
 }
 
-func (*ListBookingsRequest) ProtoMessage() {}
+func (x *Transfer) GetRecipientEmail() string {
+	if x != nil {
+		return x.RecipientEmail
+	}
+	return ""
+}
 
-func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[4]
+func (x *Transfer) GetState() TransferState {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return TransferState_TRANSFER_STATE_UNSPECIFIED
 }
 
-// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
-func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{4}
+func (x *Transfer) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-type ListBookingsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Transfer) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
-func (x *ListBookingsReply) Reset() {
-	*x = ListBookingsReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[5]
+func (x *Transfer) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Transfer) GetResolvedBy() uint64 {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return 0
+}
+
+type TransferBookingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // booking
+	RecipientEmail string                 `protobuf:"bytes,3,opt,name=recipient_email,json=recipientEmail,proto3" json:"recipient_email,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferBookingRequest) Reset() {
+	*x = TransferBookingRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBookingsReply) String() string {
+func (x *TransferBookingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBookingsReply) ProtoMessage() {}
+func (*TransferBookingRequest) ProtoMessage() {}
 
-func (x *ListBookingsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[5]
+func (x *TransferBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,40 +2762,47 @@ func (x *ListBookingsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBookingsReply.ProtoReflect.Descriptor instead.
-func (*ListBookingsReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TransferBookingRequest.ProtoReflect.Descriptor instead.
+func (*TransferBookingRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListBookingsReply) GetBookings() []*Booking {
+func (x *TransferBookingRequest) GetId() uint64 {
 	if x != nil {
-		return x.Bookings
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type CancelBookingRequest struct {
+func (x *TransferBookingRequest) GetRecipientEmail() string {
+	if x != nil {
+		return x.RecipientEmail
+	}
+	return ""
+}
+
+type GetTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[6]
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *GetTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*GetTransferRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[6]
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,40 +2813,40 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CancelBookingRequest) GetId() uint64 {
+func (x *GetTransferRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ConfirmBookingRequest struct {
+type AcceptTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmBookingRequest) Reset() {
-	*x = ConfirmBookingRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[7]
+func (x *AcceptTransferRequest) Reset() {
+	*x = AcceptTransferRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmBookingRequest) String() string {
+func (x *AcceptTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmBookingRequest) ProtoMessage() {}
+func (*AcceptTransferRequest) ProtoMessage() {}
 
-func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[7]
+func (x *AcceptTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,40 +2857,40 @@ func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
-func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AcceptTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTransferRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *ConfirmBookingRequest) GetId() uint64 {
+func (x *AcceptTransferRequest) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ConfirmBookingReply struct {
+type CancelTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConfirmBookingReply) Reset() {
-	*x = ConfirmBookingReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[8]
+func (x *CancelTransferRequest) Reset() {
+	*x = CancelTransferRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConfirmBookingReply) String() string {
+func (x *CancelTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfirmBookingReply) ProtoMessage() {}
+func (*CancelTransferRequest) ProtoMessage() {}
 
-func (x *ConfirmBookingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[8]
+func (x *CancelTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,41 +2901,41 @@ func (x *ConfirmBookingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfirmBookingReply.ProtoReflect.Descriptor instead.
-func (*ConfirmBookingReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CancelTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelTransferRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ConfirmBookingReply) GetStatus() string {
+func (x *CancelTransferRequest) GetId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type UpdateBookingRequest struct {
+type TransferReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Booking       *Booking               `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"` // set by AcceptTransfer
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingRequest) Reset() {
-	*x = UpdateBookingRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[9]
+func (x *TransferReply) Reset() {
+	*x = TransferReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingRequest) String() string {
+func (x *TransferReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingRequest) ProtoMessage() {}
+func (*TransferReply) ProtoMessage() {}
 
-func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[9]
+func (x *TransferReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,47 +2946,47 @@ func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TransferReply.ProtoReflect.Descriptor instead.
+func (*TransferReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateBookingRequest) GetId() uint64 {
+func (x *TransferReply) GetTransfer() *Transfer {
 	if x != nil {
-		return x.Id
+		return x.Transfer
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateBookingRequest) GetStatus() string {
+func (x *TransferReply) GetBooking() *Booking {
 	if x != nil {
-		return x.Status
+		return x.Booking
 	}
-	return ""
+	return nil
 }
 
-type UpdateBookingReply struct {
+type ListBookingTransfersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingReply) Reset() {
-	*x = UpdateBookingReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[10]
+func (x *ListBookingTransfersRequest) Reset() {
+	*x = ListBookingTransfersRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingReply) String() string {
+func (x *ListBookingTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingReply) ProtoMessage() {}
+func (*ListBookingTransfersRequest) ProtoMessage() {}
 
-func (x *UpdateBookingReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[10]
+func (x *ListBookingTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,40 +2997,40 @@ func (x *UpdateBookingReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingReply.ProtoReflect.Descriptor instead.
-func (*UpdateBookingReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListBookingTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateBookingReply) GetSuccess() bool {
+func (x *ListBookingTransfersRequest) GetId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-type GetLockedSeatsRequest struct {
+type ListBookingTransfersReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLockedSeatsRequest) Reset() {
-	*x = GetLockedSeatsRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[11]
+func (x *ListBookingTransfersReply) Reset() {
+	*x = ListBookingTransfersReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLockedSeatsRequest) String() string {
+func (x *ListBookingTransfersReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLockedSeatsRequest) ProtoMessage() {}
+func (*ListBookingTransfersReply) ProtoMessage() {}
 
-func (x *GetLockedSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[11]
+func (x *ListBookingTransfersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,40 +3041,41 @@ func (x *GetLockedSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLockedSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetLockedSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListBookingTransfersReply.ProtoReflect.Descriptor instead.
+func (*ListBookingTransfersReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetLockedSeatsRequest) GetEventId() uint64 {
+func (x *ListBookingTransfersReply) GetTransfers() []*Transfer {
 	if x != nil {
-		return x.EventId
+		return x.Transfers
 	}
-	return 0
+	return nil
 }
 
-type GetLockedSeatsReply struct {
+// Event messages
+type GetEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLockedSeatsReply) Reset() {
-	*x = GetLockedSeatsReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[12]
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLockedSeatsReply) String() string {
+func (x *GetEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLockedSeatsReply) ProtoMessage() {}
+func (*GetEventRequest) ProtoMessage() {}
 
-func (x *GetLockedSeatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[12]
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,42 +3086,44 @@ func (x *GetLockedSeatsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLockedSeatsReply.ProtoReflect.Descriptor instead.
-func (*GetLockedSeatsReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetLockedSeatsReply) GetSeatIds() []string {
+func (x *GetEventRequest) GetId() uint64 {
 	if x != nil {
-		return x.SeatIds
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type LockSeatRequest struct {
+type GetEventReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	TotalSeats    uint32                 `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	PricePerSeat  uint32                 `protobuf:"varint,5,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LockSeatRequest) Reset() {
-	*x = LockSeatRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[13]
+func (x *GetEventReply) Reset() {
+	*x = GetEventReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LockSeatRequest) String() string {
+func (x *GetEventReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockSeatRequest) ProtoMessage() {}
+func (*GetEventReply) ProtoMessage() {}
 
-func (x *LockSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[13]
+func (x *GetEventReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,54 +3134,82 @@ func (x *LockSeatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockSeatRequest.ProtoReflect.Descriptor instead.
-func (*LockSeatRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetEventReply.ProtoReflect.Descriptor instead.
+func (*GetEventReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEventReply) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetEventReply) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *LockSeatRequest) GetEventId() uint64 {
+func (x *GetEventReply) GetDate() string {
 	if x != nil {
-		return x.EventId
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *LockSeatRequest) GetSeatIds() []string {
+func (x *GetEventReply) GetTotalSeats() uint32 {
 	if x != nil {
-		return x.SeatIds
+		return x.TotalSeats
 	}
-	return nil
+	return 0
 }
 
-func (x *LockSeatRequest) GetUserId() uint64 {
+func (x *GetEventReply) GetPricePerSeat() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.PricePerSeat
 	}
 	return 0
 }
 
-type LockSeatReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locked        bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+type Ticket struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId uint64                 `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	EventId   uint64                 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	SeatId    string                 `protobuf:"bytes,4,opt,name=seat_id,json=seatId,proto3" json:"seat_id,omitempty"`
+	Code      string                 `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"` // unique per ticket
+	Status    TicketStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=booking.v1.TicketStatus" json:"status,omitempty"`
+	// QR payload of a VALID ticket: "<key_id>.<claims>.<signature>", where
+	// claims is the base64url JSON {"t":ticket,"b":booking,"e":event,"s":seat,"c":code}
+	// and signature the base64url HMAC-SHA256 of "<key_id>.<claims>".
+	Payload       string `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	KeyId         string `protobuf:"bytes,8,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`                      // key that signed the payload
+	IssuedAt      string `protobuf:"bytes,9,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`             // RFC3339
+	VoidedAt      string `protobuf:"bytes,10,opt,name=voided_at,json=voidedAt,proto3" json:"voided_at,omitempty"`            // RFC3339, set once VOID
+	CheckedInAt   string `protobuf:"bytes,11,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"` // RFC3339, set once admitted at the door
+	CheckedInGate string `protobuf:"bytes,12,opt,name=checked_in_gate,json=checkedInGate,proto3" json:"checked_in_gate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LockSeatReply) Reset() {
-	*x = LockSeatReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[14]
+func (x *Ticket) Reset() {
+	*x = Ticket{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LockSeatReply) String() string {
+func (x *Ticket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LockSeatReply) ProtoMessage() {}
+func (*Ticket) ProtoMessage() {}
 
-func (x *LockSeatReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[14]
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,144 +3220,118 @@ func (x *LockSeatReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LockSeatReply.ProtoReflect.Descriptor instead.
-func (*LockSeatReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *LockSeatReply) GetLocked() bool {
+func (x *Ticket) GetId() uint64 {
 	if x != nil {
-		return x.Locked
+		return x.Id
 	}
-	return false
-}
-
-type UnlockSeatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
-	UserId        uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UnlockSeatRequest) Reset() {
-	*x = UnlockSeatRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UnlockSeatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*UnlockSeatRequest) ProtoMessage() {}
-
-func (x *UnlockSeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[15]
+func (x *Ticket) GetBookingId() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BookingId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnlockSeatRequest.ProtoReflect.Descriptor instead.
-func (*UnlockSeatRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{15}
+	return 0
 }
 
-func (x *UnlockSeatRequest) GetEventId() uint64 {
+func (x *Ticket) GetEventId() uint64 {
 	if x != nil {
 		return x.EventId
 	}
 	return 0
 }
 
-func (x *UnlockSeatRequest) GetSeatIds() []string {
+func (x *Ticket) GetSeatId() string {
 	if x != nil {
-		return x.SeatIds
+		return x.SeatId
 	}
-	return nil
+	return ""
 }
 
-func (x *UnlockSeatRequest) GetUserId() uint64 {
+func (x *Ticket) GetCode() string {
 	if x != nil {
-		return x.UserId
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type UnlockSeatReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Ticket) GetStatus() TicketStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TicketStatus_TICKET_STATUS_UNSPECIFIED
 }
 
-func (x *UnlockSeatReply) Reset() {
-	*x = UnlockSeatReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Ticket) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
 }
 
-func (x *UnlockSeatReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Ticket) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
 }
 
-func (*UnlockSeatReply) ProtoMessage() {}
+func (x *Ticket) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
 
-func (x *UnlockSeatReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[16]
+func (x *Ticket) GetVoidedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VoidedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UnlockSeatReply.ProtoReflect.Descriptor instead.
-func (*UnlockSeatReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{16}
+func (x *Ticket) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
 }
 
-func (x *UnlockSeatReply) GetSuccess() bool {
+func (x *Ticket) GetCheckedInGate() string {
 	if x != nil {
-		return x.Success
+		return x.CheckedInGate
 	}
-	return false
+	return ""
 }
 
-type GetBookedSeatsRequest struct {
+type ListTicketsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // booking
+	IncludeVoid   bool                   `protobuf:"varint,2,opt,name=include_void,json=includeVoid,proto3" json:"include_void,omitempty"` // also list voided tickets
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookedSeatsRequest) Reset() {
-	*x = GetBookedSeatsRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[17]
+func (x *ListTicketsRequest) Reset() {
+	*x = ListTicketsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsRequest) String() string {
+func (x *ListTicketsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsRequest) ProtoMessage() {}
+func (*ListTicketsRequest) ProtoMessage() {}
 
-func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[17]
+func (x *ListTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,40 +3342,47 @@ func (x *GetBookedSeatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsRequest.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListTicketsRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetBookedSeatsRequest) GetEventId() uint64 {
+func (x *ListTicketsRequest) GetId() uint64 {
 	if x != nil {
-		return x.EventId
+		return x.Id
 	}
 	return 0
 }
 
-type GetBookedSeatsReply struct {
+func (x *ListTicketsRequest) GetIncludeVoid() bool {
+	if x != nil {
+		return x.IncludeVoid
+	}
+	return false
+}
+
+type ListTicketsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeatIds       []string               `protobuf:"bytes,1,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Tickets       []*Ticket              `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookedSeatsReply) Reset() {
-	*x = GetBookedSeatsReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[18]
+func (x *ListTicketsReply) Reset() {
+	*x = ListTicketsReply{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookedSeatsReply) String() string {
+func (x *ListTicketsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookedSeatsReply) ProtoMessage() {}
+func (*ListTicketsReply) ProtoMessage() {}
 
-func (x *GetBookedSeatsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[18]
+func (x *ListTicketsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,41 +3393,40 @@ func (x *GetBookedSeatsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookedSeatsReply.ProtoReflect.Descriptor instead.
-func (*GetBookedSeatsReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListTicketsReply.ProtoReflect.Descriptor instead.
+func (*ListTicketsReply) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetBookedSeatsReply) GetSeatIds() []string {
+func (x *ListTicketsReply) GetTickets() []*Ticket {
 	if x != nil {
-		return x.SeatIds
+		return x.Tickets
 	}
 	return nil
 }
 
-// Event messages
-type GetEventRequest struct {
+type WatchSeatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[19]
+func (x *WatchSeatsRequest) Reset() {
+	*x = WatchSeatsRequest{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRequest) String() string {
+func (x *WatchSeatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRequest) ProtoMessage() {}
+func (*WatchSeatsRequest) ProtoMessage() {}
 
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[19]
+func (x *WatchSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,44 +3437,45 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use WatchSeatsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetEventRequest) GetId() uint64 {
+func (x *WatchSeatsRequest) GetEventId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return 0
 }
 
-type GetEventReply struct {
+type SeatUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	TotalSeats    uint32                 `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	PricePerSeat  uint32                 `protobuf:"varint,5,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
+	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Kind          SeatUpdateKind         `protobuf:"varint,2,opt,name=kind,proto3,enum=booking.v1.SeatUpdateKind" json:"kind,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,3,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, for SEAT_HELD; holds lapse silently at this time
+	At            string                 `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`                                // RFC3339
+	Snapshot      bool                   `protobuf:"varint,6,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                   // part of the current state sent when the stream opens
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventReply) Reset() {
-	*x = GetEventReply{}
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[20]
+func (x *SeatUpdate) Reset() {
+	*x = SeatUpdate{}
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventReply) String() string {
+func (x *SeatUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventReply) ProtoMessage() {}
+func (*SeatUpdate) ProtoMessage() {}
 
-func (x *GetEventReply) ProtoReflect() protoreflect.Message {
-	mi := &file_bookingservice_v1_booking_proto_msgTypes[20]
+func (x *SeatUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_bookingservice_v1_booking_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,44 +3486,51 @@ func (x *GetEventReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventReply.ProtoReflect.Descriptor instead.
-func (*GetEventReply) Descriptor() ([]byte, []int) {
-	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SeatUpdate.ProtoReflect.Descriptor instead.
+func (*SeatUpdate) Descriptor() ([]byte, []int) {
+	return file_bookingservice_v1_booking_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetEventReply) GetId() uint64 {
+func (x *SeatUpdate) GetEventId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.EventId
 	}
 	return 0
 }
 
-func (x *GetEventReply) GetTitle() string {
+func (x *SeatUpdate) GetKind() SeatUpdateKind {
 	if x != nil {
-		return x.Title
+		return x.Kind
 	}
-	return ""
+	return SeatUpdateKind_SEAT_UPDATE_KIND_UNSPECIFIED
 }
 
-func (x *GetEventReply) GetDate() string {
+func (x *SeatUpdate) GetSeatIds() []string {
 	if x != nil {
-		return x.Date
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *SeatUpdate) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-func (x *GetEventReply) GetTotalSeats() uint32 {
+func (x *SeatUpdate) GetAt() string {
 	if x != nil {
-		return x.TotalSeats
+		return x.At
 	}
-	return 0
+	return ""
 }
 
-func (x *GetEventReply) GetPricePerSeat() uint32 {
+func (x *SeatUpdate) GetSnapshot() bool {
 	if x != nil {
-		return x.PricePerSeat
+		return x.Snapshot
 	}
-	return 0
+	return false
 }
 
 var File_bookingservice_v1_booking_proto protoreflect.FileDescriptor
@@ -1081,59 +3538,218 @@ var File_bookingservice_v1_booking_proto protoreflect.FileDescriptor
 const file_bookingservice_v1_booking_proto_rawDesc = "" +
 	"\n" +
 	"\x1fbookingservice/v1/booking.proto\x12\n" +
-	"booking.v1\x1a\x1cgoogle/api/annotations.proto\"\xbe\x01\n" +
+	"booking.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x14money/v1/money.proto\"\x98\x03\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x19\n" +
-	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1f\n" +
+	"\vticket_code\x18\b \x01(\tR\n" +
+	"ticketCode\x12.\n" +
 	"\n" +
-	"total_cost\x18\a \x01(\x02R\ttotalCost\"e\n" +
+	"total_cost\x18\t \x01(\v2\x0f.money.v1.MoneyR\ttotalCost\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\n" +
+	" \x01(\tR\tpromoCode\x12+\n" +
+	"\bdiscount\x18\v \x01(\v2\x0f.money.v1.MoneyR\bdiscount\x129\n" +
+	"\ahistory\x18\f \x03(\v2\x1f.booking.v1.BookingStatusChangeR\ahistoryJ\x04\b\a\x10\b\"\xf5\x01\n" +
 	"\x14CreateBookingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12\x19\n" +
-	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\"C\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x04 \x01(\tR\tholdToken\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x06 \x01(\tR\tpromoCode\x12'\n" +
+	"\x0fadmission_token\x18\a \x01(\tR\x0eadmissionToken\"C\n" +
 	"\x12CreateBookingReply\x12-\n" +
-	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"#\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\"L\n" +
 	"\x11GetBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x15\n" +
-	"\x13ListBookingsRequest\"D\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0finclude_history\x18\x02 \x01(\bR\x0eincludeHistory\"\xba\x02\n" +
+	"\x13BookingStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x04R\tbookingId\x12:\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x19.booking.v1.BookingStatusR\n" +
+	"fromStatus\x126\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x19.booking.v1.BookingStatusR\btoStatus\x12.\n" +
+	"\x05actor\x18\x05 \x01(\x0e2\x18.booking.v1.BookingActorR\x05actor\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\tR\tchangedAt\"*\n" +
+	"\x18GetBookingHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"S\n" +
+	"\x16GetBookingHistoryReply\x129\n" +
+	"\achanges\x18\x01 \x03(\v2\x1f.booking.v1.BookingStatusChangeR\achanges\"\xb1\x02\n" +
+	"\x13ListBookingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12#\n" +
+	"\rcreated_after\x18\x04 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x05 \x01(\tR\rcreatedBefore\x12+\n" +
+	"\x04sort\x18\x06 \x01(\x0e2\x17.booking.v1.BookingSortR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"\x9a\x02\n" +
+	"\x15ListMyBookingsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12#\n" +
+	"\rcreated_after\x18\x03 \x01(\tR\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x04 \x01(\tR\rcreatedBefore\x12+\n" +
+	"\x04sort\x18\x05 \x01(\x0e2\x17.booking.v1.BookingSortR\x04sort\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"l\n" +
 	"\x11ListBookingsReply\x12/\n" +
-	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\"&\n" +
+	"\bbookings\x18\x01 \x03(\v2\x13.booking.v1.BookingR\bbookings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"M\n" +
 	"\x14CancelBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"'\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonJ\x04\b\x02\x10\x03R\auser_id\"?\n" +
+	"\x12CancelSeatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\"\x7f\n" +
+	"\x10CancelSeatsReply\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x12'\n" +
+	"\x06refund\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\x06refundJ\x04\b\x02\x10\x03R\rrefund_amount\"\x96\x01\n" +
+	"\x12ChangeSeatsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12 \n" +
+	"\fold_seat_ids\x18\x03 \x03(\tR\n" +
+	"oldSeatIds\x12 \n" +
+	"\fnew_seat_ids\x18\x04 \x03(\tR\n" +
+	"newSeatIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x05 \x01(\tR\tholdTokenJ\x04\b\x02\x10\x03R\auser_id\"\x83\x01\n" +
+	"\x10ChangeSeatsReply\x12-\n" +
+	"\abooking\x18\x01 \x01(\v2\x13.booking.v1.BookingR\abooking\x12:\n" +
+	"\x10price_difference\x18\x03 \x01(\v2\x0f.money.v1.MoneyR\x0fpriceDifferenceJ\x04\b\x02\x10\x03\"'\n" +
 	"\x15ConfirmBookingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"-\n" +
 	"\x13ConfirmBookingReply\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\">\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xad\x01\n" +
 	"\x14UpdateBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\".\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.booking.v1.BookingStatusR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x06 \x01(\x04R\tpaymentIdJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x05actorR\bactor_id\".\n" +
 	"\x12UpdateBookingReply\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"j\n" +
 	"\x15GetLockedSeatsRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\x04R\aeventId\"0\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x03 \x01(\tR\tholdToken\"l\n" +
+	"\n" +
+	"LockedSeat\x12\x17\n" +
+	"\aseat_id\x18\x01 \x01(\tR\x06seatId\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tR\texpiresAt\x12&\n" +
+	"\x0fowned_by_caller\x18\x03 \x01(\bR\rownedByCaller\"^\n" +
 	"\x13GetLockedSeatsReply\x12\x19\n" +
-	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\"`\n" +
+	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\x12,\n" +
+	"\x05seats\x18\x02 \x03(\v2\x16.booking.v1.LockedSeatR\x05seats\"\x89\x01\n" +
 	"\x0fLockSeatRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x19\n" +
 	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x04R\x06userId\"'\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12'\n" +
+	"\x0fadmission_token\x18\x04 \x01(\tR\x0eadmissionToken\"\x97\x01\n" +
 	"\rLockSeatReply\x12\x16\n" +
-	"\x06locked\x18\x01 \x01(\bR\x06locked\"b\n" +
+	"\x06locked\x18\x01 \x01(\bR\x06locked\x120\n" +
+	"\x14conflicting_seat_ids\x18\x02 \x03(\tR\x12conflictingSeatIds\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x03 \x01(\tR\tholdToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"\x81\x01\n" +
 	"\x11UnlockSeatRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x19\n" +
 	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x04R\x06userId\"+\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x04 \x01(\tR\tholdToken\"+\n" +
 	"\x0fUnlockSeatReply\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"2\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x01\n" +
+	"\x15ExtendSeatHoldRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x19\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"hold_token\x18\x04 \x01(\tR\tholdToken\"]\n" +
+	"\x13ExtendSeatHoldReply\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x01 \x01(\tR\texpiresAt\x12'\n" +
+	"\x0fextensions_left\x18\x02 \x01(\x05R\x0eextensionsLeft\"2\n" +
 	"\x15GetBookedSeatsRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x04R\aeventId\"0\n" +
 	"\x13GetBookedSeatsReply\x12\x19\n" +
-	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\"!\n" +
+	"\bseat_ids\x18\x01 \x03(\tR\aseatIds\"\xa7\x02\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\x04R\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x04 \x01(\x05R\tseatCount\x12/\n" +
+	"\x05state\x18\x05 \x01(\x0e2\x19.booking.v1.WaitlistStateR\x05state\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12(\n" +
+	"\x10offer_expires_at\x18\a \x01(\tR\x0eofferExpiresAt\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\b \x01(\x04R\tbookingId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"^\n" +
+	"\x13JoinWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x03 \x01(\x05R\tseatCountJ\x04\b\x02\x10\x03R\auser_id\")\n" +
+	"\x17GetWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"5\n" +
+	"\x14LeaveWaitlistRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02idJ\x04\b\x02\x10\x03R\auser_id\"V\n" +
+	"\x1aAcceptWaitlistOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIdsJ\x04\b\x02\x10\x03R\auser_id\"E\n" +
+	"\x12WaitlistEntryReply\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.booking.v1.WaitlistEntryR\x05entry\"\xd3\x02\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x04R\tbookingId\x12 \n" +
+	"\ffrom_user_id\x18\x03 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x04 \x01(\x04R\btoUserId\x12'\n" +
+	"\x0frecipient_email\x18\x05 \x01(\tR\x0erecipientEmail\x12/\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x19.booking.v1.TransferStateR\x05state\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\x12\x1f\n" +
+	"\vresolved_at\x18\t \x01(\tR\n" +
+	"resolvedAt\x12\x1f\n" +
+	"\vresolved_by\x18\n" +
+	" \x01(\x04R\n" +
+	"resolvedBy\"`\n" +
+	"\x16TransferBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0frecipient_email\x18\x03 \x01(\tR\x0erecipientEmailJ\x04\b\x02\x10\x03R\auser_id\"$\n" +
+	"\x12GetTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"6\n" +
+	"\x15AcceptTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02idJ\x04\b\x02\x10\x03R\auser_id\"6\n" +
+	"\x15CancelTransferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02idJ\x04\b\x02\x10\x03R\auser_id\"p\n" +
+	"\rTransferReply\x120\n" +
+	"\btransfer\x18\x01 \x01(\v2\x14.booking.v1.TransferR\btransfer\x12-\n" +
+	"\abooking\x18\x02 \x01(\v2\x13.booking.v1.BookingR\abooking\"-\n" +
+	"\x1bListBookingTransfersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"O\n" +
+	"\x19ListBookingTransfersReply\x122\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x14.booking.v1.TransferR\ttransfers\"!\n" +
 	"\x0fGetEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"\x90\x01\n" +
 	"\rGetEventReply\x12\x0e\n" +
@@ -1142,20 +3758,109 @@ const file_bookingservice_v1_booking_proto_rawDesc = "" +
 	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1f\n" +
 	"\vtotal_seats\x18\x04 \x01(\rR\n" +
 	"totalSeats\x12$\n" +
-	"\x0eprice_per_seat\x18\x05 \x01(\rR\fpricePerSeat2\xea\t\n" +
+	"\x0eprice_per_seat\x18\x05 \x01(\rR\fpricePerSeat\"\xe8\x02\n" +
+	"\x06Ticket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\x04R\tbookingId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x04R\aeventId\x12\x17\n" +
+	"\aseat_id\x18\x04 \x01(\tR\x06seatId\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x120\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x18.booking.v1.TicketStatusR\x06status\x12\x18\n" +
+	"\apayload\x18\a \x01(\tR\apayload\x12\x15\n" +
+	"\x06key_id\x18\b \x01(\tR\x05keyId\x12\x1b\n" +
+	"\tissued_at\x18\t \x01(\tR\bissuedAt\x12\x1b\n" +
+	"\tvoided_at\x18\n" +
+	" \x01(\tR\bvoidedAt\x12\"\n" +
+	"\rchecked_in_at\x18\v \x01(\tR\vcheckedInAt\x12&\n" +
+	"\x0fchecked_in_gate\x18\f \x01(\tR\rcheckedInGate\"G\n" +
+	"\x12ListTicketsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\finclude_void\x18\x02 \x01(\bR\vincludeVoid\"@\n" +
+	"\x10ListTicketsReply\x12,\n" +
+	"\atickets\x18\x01 \x03(\v2\x12.booking.v1.TicketR\atickets\".\n" +
+	"\x11WatchSeatsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\"\xbd\x01\n" +
+	"\n" +
+	"SeatUpdate\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12.\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1a.booking.v1.SeatUpdateKindR\x04kind\x12\x19\n" +
+	"\bseat_ids\x18\x03 \x03(\tR\aseatIds\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12\x0e\n" +
+	"\x02at\x18\x05 \x01(\tR\x02at\x12\x1a\n" +
+	"\bsnapshot\x18\x06 \x01(\bR\bsnapshot*u\n" +
+	"\rBookingStatus\x12\x1e\n" +
+	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\r\n" +
+	"\tCONFIRMED\x10\x02\x12\r\n" +
+	"\tCANCELLED\x10\x03\x12\v\n" +
+	"\aEXPIRED\x10\x04\x12\f\n" +
+	"\bREFUNDED\x10\x05*b\n" +
+	"\fBookingActor\x12\x1d\n" +
+	"\x19BOOKING_ACTOR_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"ACTOR_USER\x10\x01\x12\x11\n" +
+	"\rACTOR_PAYMENT\x10\x02\x12\x10\n" +
+	"\fACTOR_SYSTEM\x10\x03*_\n" +
+	"\vBookingSort\x12\x13\n" +
+	"\x0fCREATED_AT_DESC\x10\x00\x12\x12\n" +
+	"\x0eCREATED_AT_ASC\x10\x01\x12\x13\n" +
+	"\x0fTOTAL_COST_DESC\x10\x02\x12\x12\n" +
+	"\x0eTOTAL_COST_ASC\x10\x03*\x9b\x01\n" +
+	"\rWaitlistState\x12\x1e\n" +
+	"\x1aWAITLIST_STATE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10WAITLIST_WAITING\x10\x01\x12\x14\n" +
+	"\x10WAITLIST_OFFERED\x10\x02\x12\x15\n" +
+	"\x11WAITLIST_ACCEPTED\x10\x03\x12\x14\n" +
+	"\x10WAITLIST_EXPIRED\x10\x04\x12\x11\n" +
+	"\rWAITLIST_LEFT\x10\x05*\x8a\x01\n" +
+	"\rTransferState\x12\x1e\n" +
+	"\x1aTRANSFER_STATE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TRANSFER_PENDING\x10\x01\x12\x15\n" +
+	"\x11TRANSFER_ACCEPTED\x10\x02\x12\x16\n" +
+	"\x12TRANSFER_CANCELLED\x10\x03\x12\x14\n" +
+	"\x10TRANSFER_EXPIRED\x10\x04*P\n" +
+	"\fTicketStatus\x12\x1d\n" +
+	"\x19TICKET_STATUS_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fTICKET_VALID\x10\x01\x12\x0f\n" +
+	"\vTICKET_VOID\x10\x02*y\n" +
+	"\x0eSeatUpdateKind\x12 \n" +
+	"\x1cSEAT_UPDATE_KIND_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tSEAT_HELD\x10\x01\x12\x11\n" +
+	"\rSEAT_RELEASED\x10\x02\x12\x0f\n" +
+	"\vSEAT_BOOKED\x10\x03\x12\x12\n" +
+	"\x0eSEAT_CANCELLED\x10\x042\xb3\x18\n" +
 	"\x0eBookingService\x12j\n" +
 	"\rCreateBooking\x12 .booking.v1.CreateBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/bookings\x12f\n" +
 	"\n" +
-	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/bookings/{id}\x12d\n" +
-	"\fListBookings\x12\x1f.booking.v1.ListBookingsRequest\x1a\x1d.booking.v1.ListBookingsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/bookings\x12o\n" +
-	"\rUpdateBooking\x12 .booking.v1.UpdateBookingRequest\x1a\x1e.booking.v1.UpdateBookingReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/bookings/{id}\x12o\n" +
-	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/bookings/{id}\x12y\n" +
+	"GetBooking\x12\x1d.booking.v1.GetBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/bookings/{id}\x12\x80\x01\n" +
+	"\x11GetBookingHistory\x12$.booking.v1.GetBookingHistoryRequest\x1a\".booking.v1.GetBookingHistoryReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/bookings/{id}/history\x12d\n" +
+	"\fListBookings\x12\x1f.booking.v1.ListBookingsRequest\x1a\x1d.booking.v1.ListBookingsReply\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/bookings\x12k\n" +
+	"\x0eListMyBookings\x12!.booking.v1.ListMyBookingsRequest\x1a\x1d.booking.v1.ListBookingsReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/me/bookings\x12Q\n" +
+	"\rUpdateBooking\x12 .booking.v1.UpdateBookingRequest\x1a\x1e.booking.v1.UpdateBookingReply\x12o\n" +
+	"\rCancelBooking\x12 .booking.v1.CancelBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/v1/bookings/{id}\x12v\n" +
+	"\vCancelSeats\x12\x1e.booking.v1.CancelSeatsRequest\x1a\x1c.booking.v1.CancelSeatsReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/bookings/{id}/cancel-seats\x12v\n" +
+	"\vChangeSeats\x12\x1e.booking.v1.ChangeSeatsRequest\x1a\x1c.booking.v1.ChangeSeatsReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/bookings/{id}/change-seats\x12y\n" +
 	"\x0eConfirmBooking\x12!.booking.v1.ConfirmBookingRequest\x1a\x1e.booking.v1.CreateBookingReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/v1/bookings/{id}/confirm\x12}\n" +
 	"\x0eGetBookedSeats\x12!.booking.v1.GetBookedSeatsRequest\x1a\x1f.booking.v1.GetBookedSeatsReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/events/{event_id}/booked-seats\x12}\n" +
-	"\x0eGetLockedSeats\x12!.booking.v1.GetLockedSeatsRequest\x1a\x1f.booking.v1.GetLockedSeatsReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/events/{event_id}/locked-seats\x12n\n" +
+	"\x0eGetLockedSeats\x12!.booking.v1.GetLockedSeatsRequest\x1a\x1f.booking.v1.GetLockedSeatsReply\"'\x82\xd3\xe4\x93\x02!\x12\x1f/events/{event_id}/locked-seats\x12E\n" +
+	"\n" +
+	"WatchSeats\x12\x1d.booking.v1.WatchSeatsRequest\x1a\x16.booking.v1.SeatUpdate0\x01\x12n\n" +
 	"\bLockSeat\x12\x1b.booking.v1.LockSeatRequest\x1a\x19.booking.v1.LockSeatReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/events/{event_id}/lock-seat\x12v\n" +
 	"\n" +
-	"UnlockSeat\x12\x1d.booking.v1.UnlockSeatRequest\x1a\x1b.booking.v1.UnlockSeatReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/events/{event_id}/unlock-seat\x12[\n" +
+	"UnlockSeat\x12\x1d.booking.v1.UnlockSeatRequest\x1a\x1b.booking.v1.UnlockSeatReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/events/{event_id}/unlock-seat\x12\x87\x01\n" +
+	"\x0eExtendSeatHold\x12!.booking.v1.ExtendSeatHoldRequest\x1a\x1f.booking.v1.ExtendSeatHoldReply\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/events/{event_id}/extend-seat-hold\x12z\n" +
+	"\fJoinWaitlist\x12\x1f.booking.v1.JoinWaitlistRequest\x1a\x1e.booking.v1.WaitlistEntryReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/{event_id}/waitlist\x12r\n" +
+	"\x10GetWaitlistEntry\x12#.booking.v1.GetWaitlistEntryRequest\x1a\x1e.booking.v1.WaitlistEntryReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/waitlist/{id}\x12u\n" +
+	"\rLeaveWaitlist\x12 .booking.v1.LeaveWaitlistRequest\x1a\x1e.booking.v1.WaitlistEntryReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/waitlist/{id}/leave\x12\x82\x01\n" +
+	"\x13AcceptWaitlistOffer\x12&.booking.v1.AcceptWaitlistOfferRequest\x1a\x1e.booking.v1.CreateBookingReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/waitlist/{id}/accept\x12x\n" +
+	"\x0fTransferBooking\x12\".booking.v1.TransferBookingRequest\x1a\x19.booking.v1.TransferReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/bookings/{id}/transfers\x12d\n" +
+	"\vGetTransfer\x12\x1e.booking.v1.GetTransferRequest\x1a\x19.booking.v1.TransferReply\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/transfers/{id}\x12t\n" +
+	"\x0eAcceptTransfer\x12!.booking.v1.AcceptTransferRequest\x1a\x19.booking.v1.TransferReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/transfers/{id}/accept\x12t\n" +
+	"\x0eCancelTransfer\x12!.booking.v1.CancelTransferRequest\x1a\x19.booking.v1.TransferReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/transfers/{id}/cancel\x12\x8b\x01\n" +
+	"\x14ListBookingTransfers\x12'.booking.v1.ListBookingTransfersRequest\x1a%.booking.v1.ListBookingTransfersReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/bookings/{id}/transfers\x12n\n" +
+	"\vListTickets\x12\x1e.booking.v1.ListTicketsRequest\x1a\x1c.booking.v1.ListTicketsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/bookings/{id}/tickets\x12[\n" +
 	"\bGetEvent\x12\x1b.booking.v1.GetEventRequest\x1a\x19.booking.v1.GetEventReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/events/{id}B)Z'bookingservice/api/bookingservice/v1;v1b\x06proto3"
 
 var (
@@ -1170,60 +3875,158 @@ func file_bookingservice_v1_booking_proto_rawDescGZIP() []byte {
 	return file_bookingservice_v1_booking_proto_rawDescData
 }
 
-var file_bookingservice_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_bookingservice_v1_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_bookingservice_v1_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_bookingservice_v1_booking_proto_goTypes = []any{
-	(*Booking)(nil),               // 0: booking.v1.Booking
-	(*CreateBookingRequest)(nil),  // 1: booking.v1.CreateBookingRequest
-	(*CreateBookingReply)(nil),    // 2: booking.v1.CreateBookingReply
-	(*GetBookingRequest)(nil),     // 3: booking.v1.GetBookingRequest
-	(*ListBookingsRequest)(nil),   // 4: booking.v1.ListBookingsRequest
-	(*ListBookingsReply)(nil),     // 5: booking.v1.ListBookingsReply
-	(*CancelBookingRequest)(nil),  // 6: booking.v1.CancelBookingRequest
-	(*ConfirmBookingRequest)(nil), // 7: booking.v1.ConfirmBookingRequest
-	(*ConfirmBookingReply)(nil),   // 8: booking.v1.ConfirmBookingReply
-	(*UpdateBookingRequest)(nil),  // 9: booking.v1.UpdateBookingRequest
-	(*UpdateBookingReply)(nil),    // 10: booking.v1.UpdateBookingReply
-	(*GetLockedSeatsRequest)(nil), // 11: booking.v1.GetLockedSeatsRequest
-	(*GetLockedSeatsReply)(nil),   // 12: booking.v1.GetLockedSeatsReply
-	(*LockSeatRequest)(nil),       // 13: booking.v1.LockSeatRequest
-	(*LockSeatReply)(nil),         // 14: booking.v1.LockSeatReply
-	(*UnlockSeatRequest)(nil),     // 15: booking.v1.UnlockSeatRequest
-	(*UnlockSeatReply)(nil),       // 16: booking.v1.UnlockSeatReply
-	(*GetBookedSeatsRequest)(nil), // 17: booking.v1.GetBookedSeatsRequest
-	(*GetBookedSeatsReply)(nil),   // 18: booking.v1.GetBookedSeatsReply
-	(*GetEventRequest)(nil),       // 19: booking.v1.GetEventRequest
-	(*GetEventReply)(nil),         // 20: booking.v1.GetEventReply
+	(BookingStatus)(0),                  // 0: booking.v1.BookingStatus
+	(BookingActor)(0),                   // 1: booking.v1.BookingActor
+	(BookingSort)(0),                    // 2: booking.v1.BookingSort
+	(WaitlistState)(0),                  // 3: booking.v1.WaitlistState
+	(TransferState)(0),                  // 4: booking.v1.TransferState
+	(TicketStatus)(0),                   // 5: booking.v1.TicketStatus
+	(SeatUpdateKind)(0),                 // 6: booking.v1.SeatUpdateKind
+	(*Booking)(nil),                     // 7: booking.v1.Booking
+	(*CreateBookingRequest)(nil),        // 8: booking.v1.CreateBookingRequest
+	(*CreateBookingReply)(nil),          // 9: booking.v1.CreateBookingReply
+	(*GetBookingRequest)(nil),           // 10: booking.v1.GetBookingRequest
+	(*BookingStatusChange)(nil),         // 11: booking.v1.BookingStatusChange
+	(*GetBookingHistoryRequest)(nil),    // 12: booking.v1.GetBookingHistoryRequest
+	(*GetBookingHistoryReply)(nil),      // 13: booking.v1.GetBookingHistoryReply
+	(*ListBookingsRequest)(nil),         // 14: booking.v1.ListBookingsRequest
+	(*ListMyBookingsRequest)(nil),       // 15: booking.v1.ListMyBookingsRequest
+	(*ListBookingsReply)(nil),           // 16: booking.v1.ListBookingsReply
+	(*CancelBookingRequest)(nil),        // 17: booking.v1.CancelBookingRequest
+	(*CancelSeatsRequest)(nil),          // 18: booking.v1.CancelSeatsRequest
+	(*CancelSeatsReply)(nil),            // 19: booking.v1.CancelSeatsReply
+	(*ChangeSeatsRequest)(nil),          // 20: booking.v1.ChangeSeatsRequest
+	(*ChangeSeatsReply)(nil),            // 21: booking.v1.ChangeSeatsReply
+	(*ConfirmBookingRequest)(nil),       // 22: booking.v1.ConfirmBookingRequest
+	(*ConfirmBookingReply)(nil),         // 23: booking.v1.ConfirmBookingReply
+	(*UpdateBookingRequest)(nil),        // 24: booking.v1.UpdateBookingRequest
+	(*UpdateBookingReply)(nil),          // 25: booking.v1.UpdateBookingReply
+	(*GetLockedSeatsRequest)(nil),       // 26: booking.v1.GetLockedSeatsRequest
+	(*LockedSeat)(nil),                  // 27: booking.v1.LockedSeat
+	(*GetLockedSeatsReply)(nil),         // 28: booking.v1.GetLockedSeatsReply
+	(*LockSeatRequest)(nil),             // 29: booking.v1.LockSeatRequest
+	(*LockSeatReply)(nil),               // 30: booking.v1.LockSeatReply
+	(*UnlockSeatRequest)(nil),           // 31: booking.v1.UnlockSeatRequest
+	(*UnlockSeatReply)(nil),             // 32: booking.v1.UnlockSeatReply
+	(*ExtendSeatHoldRequest)(nil),       // 33: booking.v1.ExtendSeatHoldRequest
+	(*ExtendSeatHoldReply)(nil),         // 34: booking.v1.ExtendSeatHoldReply
+	(*GetBookedSeatsRequest)(nil),       // 35: booking.v1.GetBookedSeatsRequest
+	(*GetBookedSeatsReply)(nil),         // 36: booking.v1.GetBookedSeatsReply
+	(*WaitlistEntry)(nil),               // 37: booking.v1.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),         // 38: booking.v1.JoinWaitlistRequest
+	(*GetWaitlistEntryRequest)(nil),     // 39: booking.v1.GetWaitlistEntryRequest
+	(*LeaveWaitlistRequest)(nil),        // 40: booking.v1.LeaveWaitlistRequest
+	(*AcceptWaitlistOfferRequest)(nil),  // 41: booking.v1.AcceptWaitlistOfferRequest
+	(*WaitlistEntryReply)(nil),          // 42: booking.v1.WaitlistEntryReply
+	(*Transfer)(nil),                    // 43: booking.v1.Transfer
+	(*TransferBookingRequest)(nil),      // 44: booking.v1.TransferBookingRequest
+	(*GetTransferRequest)(nil),          // 45: booking.v1.GetTransferRequest
+	(*AcceptTransferRequest)(nil),       // 46: booking.v1.AcceptTransferRequest
+	(*CancelTransferRequest)(nil),       // 47: booking.v1.CancelTransferRequest
+	(*TransferReply)(nil),               // 48: booking.v1.TransferReply
+	(*ListBookingTransfersRequest)(nil), // 49: booking.v1.ListBookingTransfersRequest
+	(*ListBookingTransfersReply)(nil),   // 50: booking.v1.ListBookingTransfersReply
+	(*GetEventRequest)(nil),             // 51: booking.v1.GetEventRequest
+	(*GetEventReply)(nil),               // 52: booking.v1.GetEventReply
+	(*Ticket)(nil),                      // 53: booking.v1.Ticket
+	(*ListTicketsRequest)(nil),          // 54: booking.v1.ListTicketsRequest
+	(*ListTicketsReply)(nil),            // 55: booking.v1.ListTicketsReply
+	(*WatchSeatsRequest)(nil),           // 56: booking.v1.WatchSeatsRequest
+	(*SeatUpdate)(nil),                  // 57: booking.v1.SeatUpdate
+	(*v1.Money)(nil),                    // 58: money.v1.Money
 }
 var file_bookingservice_v1_booking_proto_depIdxs = []int32{
-	0,  // 0: booking.v1.CreateBookingReply.booking:type_name -> booking.v1.Booking
-	0,  // 1: booking.v1.ListBookingsReply.bookings:type_name -> booking.v1.Booking
-	1,  // 2: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
-	3,  // 3: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
-	4,  // 4: booking.v1.BookingService.ListBookings:input_type -> booking.v1.ListBookingsRequest
-	9,  // 5: booking.v1.BookingService.UpdateBooking:input_type -> booking.v1.UpdateBookingRequest
-	6,  // 6: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
-	7,  // 7: booking.v1.BookingService.ConfirmBooking:input_type -> booking.v1.ConfirmBookingRequest
-	17, // 8: booking.v1.BookingService.GetBookedSeats:input_type -> booking.v1.GetBookedSeatsRequest
-	11, // 9: booking.v1.BookingService.GetLockedSeats:input_type -> booking.v1.GetLockedSeatsRequest
-	13, // 10: booking.v1.BookingService.LockSeat:input_type -> booking.v1.LockSeatRequest
-	15, // 11: booking.v1.BookingService.UnlockSeat:input_type -> booking.v1.UnlockSeatRequest
-	19, // 12: booking.v1.BookingService.GetEvent:input_type -> booking.v1.GetEventRequest
-	2,  // 13: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingReply
-	2,  // 14: booking.v1.BookingService.GetBooking:output_type -> booking.v1.CreateBookingReply
-	5,  // 15: booking.v1.BookingService.ListBookings:output_type -> booking.v1.ListBookingsReply
-	10, // 16: booking.v1.BookingService.UpdateBooking:output_type -> booking.v1.UpdateBookingReply
-	2,  // 17: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CreateBookingReply
-	2,  // 18: booking.v1.BookingService.ConfirmBooking:output_type -> booking.v1.CreateBookingReply
-	18, // 19: booking.v1.BookingService.GetBookedSeats:output_type -> booking.v1.GetBookedSeatsReply
-	12, // 20: booking.v1.BookingService.GetLockedSeats:output_type -> booking.v1.GetLockedSeatsReply
-	14, // 21: booking.v1.BookingService.LockSeat:output_type -> booking.v1.LockSeatReply
-	16, // 22: booking.v1.BookingService.UnlockSeat:output_type -> booking.v1.UnlockSeatReply
-	20, // 23: booking.v1.BookingService.GetEvent:output_type -> booking.v1.GetEventReply
-	13, // [13:24] is the sub-list for method output_type
-	2,  // [2:13] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	0,  // 0: booking.v1.Booking.status:type_name -> booking.v1.BookingStatus
+	58, // 1: booking.v1.Booking.total_cost:type_name -> money.v1.Money
+	58, // 2: booking.v1.Booking.discount:type_name -> money.v1.Money
+	11, // 3: booking.v1.Booking.history:type_name -> booking.v1.BookingStatusChange
+	7,  // 4: booking.v1.CreateBookingReply.booking:type_name -> booking.v1.Booking
+	0,  // 5: booking.v1.BookingStatusChange.from_status:type_name -> booking.v1.BookingStatus
+	0,  // 6: booking.v1.BookingStatusChange.to_status:type_name -> booking.v1.BookingStatus
+	1,  // 7: booking.v1.BookingStatusChange.actor:type_name -> booking.v1.BookingActor
+	11, // 8: booking.v1.GetBookingHistoryReply.changes:type_name -> booking.v1.BookingStatusChange
+	0,  // 9: booking.v1.ListBookingsRequest.status:type_name -> booking.v1.BookingStatus
+	2,  // 10: booking.v1.ListBookingsRequest.sort:type_name -> booking.v1.BookingSort
+	0,  // 11: booking.v1.ListMyBookingsRequest.status:type_name -> booking.v1.BookingStatus
+	2,  // 12: booking.v1.ListMyBookingsRequest.sort:type_name -> booking.v1.BookingSort
+	7,  // 13: booking.v1.ListBookingsReply.bookings:type_name -> booking.v1.Booking
+	7,  // 14: booking.v1.CancelSeatsReply.booking:type_name -> booking.v1.Booking
+	58, // 15: booking.v1.CancelSeatsReply.refund:type_name -> money.v1.Money
+	7,  // 16: booking.v1.ChangeSeatsReply.booking:type_name -> booking.v1.Booking
+	58, // 17: booking.v1.ChangeSeatsReply.price_difference:type_name -> money.v1.Money
+	0,  // 18: booking.v1.UpdateBookingRequest.status:type_name -> booking.v1.BookingStatus
+	27, // 19: booking.v1.GetLockedSeatsReply.seats:type_name -> booking.v1.LockedSeat
+	3,  // 20: booking.v1.WaitlistEntry.state:type_name -> booking.v1.WaitlistState
+	37, // 21: booking.v1.WaitlistEntryReply.entry:type_name -> booking.v1.WaitlistEntry
+	4,  // 22: booking.v1.Transfer.state:type_name -> booking.v1.TransferState
+	43, // 23: booking.v1.TransferReply.transfer:type_name -> booking.v1.Transfer
+	7,  // 24: booking.v1.TransferReply.booking:type_name -> booking.v1.Booking
+	43, // 25: booking.v1.ListBookingTransfersReply.transfers:type_name -> booking.v1.Transfer
+	5,  // 26: booking.v1.Ticket.status:type_name -> booking.v1.TicketStatus
+	53, // 27: booking.v1.ListTicketsReply.tickets:type_name -> booking.v1.Ticket
+	6,  // 28: booking.v1.SeatUpdate.kind:type_name -> booking.v1.SeatUpdateKind
+	8,  // 29: booking.v1.BookingService.CreateBooking:input_type -> booking.v1.CreateBookingRequest
+	10, // 30: booking.v1.BookingService.GetBooking:input_type -> booking.v1.GetBookingRequest
+	12, // 31: booking.v1.BookingService.GetBookingHistory:input_type -> booking.v1.GetBookingHistoryRequest
+	14, // 32: booking.v1.BookingService.ListBookings:input_type -> booking.v1.ListBookingsRequest
+	15, // 33: booking.v1.BookingService.ListMyBookings:input_type -> booking.v1.ListMyBookingsRequest
+	24, // 34: booking.v1.BookingService.UpdateBooking:input_type -> booking.v1.UpdateBookingRequest
+	17, // 35: booking.v1.BookingService.CancelBooking:input_type -> booking.v1.CancelBookingRequest
+	18, // 36: booking.v1.BookingService.CancelSeats:input_type -> booking.v1.CancelSeatsRequest
+	20, // 37: booking.v1.BookingService.ChangeSeats:input_type -> booking.v1.ChangeSeatsRequest
+	22, // 38: booking.v1.BookingService.ConfirmBooking:input_type -> booking.v1.ConfirmBookingRequest
+	35, // 39: booking.v1.BookingService.GetBookedSeats:input_type -> booking.v1.GetBookedSeatsRequest
+	26, // 40: booking.v1.BookingService.GetLockedSeats:input_type -> booking.v1.GetLockedSeatsRequest
+	56, // 41: booking.v1.BookingService.WatchSeats:input_type -> booking.v1.WatchSeatsRequest
+	29, // 42: booking.v1.BookingService.LockSeat:input_type -> booking.v1.LockSeatRequest
+	31, // 43: booking.v1.BookingService.UnlockSeat:input_type -> booking.v1.UnlockSeatRequest
+	33, // 44: booking.v1.BookingService.ExtendSeatHold:input_type -> booking.v1.ExtendSeatHoldRequest
+	38, // 45: booking.v1.BookingService.JoinWaitlist:input_type -> booking.v1.JoinWaitlistRequest
+	39, // 46: booking.v1.BookingService.GetWaitlistEntry:input_type -> booking.v1.GetWaitlistEntryRequest
+	40, // 47: booking.v1.BookingService.LeaveWaitlist:input_type -> booking.v1.LeaveWaitlistRequest
+	41, // 48: booking.v1.BookingService.AcceptWaitlistOffer:input_type -> booking.v1.AcceptWaitlistOfferRequest
+	44, // 49: booking.v1.BookingService.TransferBooking:input_type -> booking.v1.TransferBookingRequest
+	45, // 50: booking.v1.BookingService.GetTransfer:input_type -> booking.v1.GetTransferRequest
+	46, // 51: booking.v1.BookingService.AcceptTransfer:input_type -> booking.v1.AcceptTransferRequest
+	47, // 52: booking.v1.BookingService.CancelTransfer:input_type -> booking.v1.CancelTransferRequest
+	49, // 53: booking.v1.BookingService.ListBookingTransfers:input_type -> booking.v1.ListBookingTransfersRequest
+	54, // 54: booking.v1.BookingService.ListTickets:input_type -> booking.v1.ListTicketsRequest
+	51, // 55: booking.v1.BookingService.GetEvent:input_type -> booking.v1.GetEventRequest
+	9,  // 56: booking.v1.BookingService.CreateBooking:output_type -> booking.v1.CreateBookingReply
+	9,  // 57: booking.v1.BookingService.GetBooking:output_type -> booking.v1.CreateBookingReply
+	13, // 58: booking.v1.BookingService.GetBookingHistory:output_type -> booking.v1.GetBookingHistoryReply
+	16, // 59: booking.v1.BookingService.ListBookings:output_type -> booking.v1.ListBookingsReply
+	16, // 60: booking.v1.BookingService.ListMyBookings:output_type -> booking.v1.ListBookingsReply
+	25, // 61: booking.v1.BookingService.UpdateBooking:output_type -> booking.v1.UpdateBookingReply
+	9,  // 62: booking.v1.BookingService.CancelBooking:output_type -> booking.v1.CreateBookingReply
+	19, // 63: booking.v1.BookingService.CancelSeats:output_type -> booking.v1.CancelSeatsReply
+	21, // 64: booking.v1.BookingService.ChangeSeats:output_type -> booking.v1.ChangeSeatsReply
+	9,  // 65: booking.v1.BookingService.ConfirmBooking:output_type -> booking.v1.CreateBookingReply
+	36, // 66: booking.v1.BookingService.GetBookedSeats:output_type -> booking.v1.GetBookedSeatsReply
+	28, // 67: booking.v1.BookingService.GetLockedSeats:output_type -> booking.v1.GetLockedSeatsReply
+	57, // 68: booking.v1.BookingService.WatchSeats:output_type -> booking.v1.SeatUpdate
+	30, // 69: booking.v1.BookingService.LockSeat:output_type -> booking.v1.LockSeatReply
+	32, // 70: booking.v1.BookingService.UnlockSeat:output_type -> booking.v1.UnlockSeatReply
+	34, // 71: booking.v1.BookingService.ExtendSeatHold:output_type -> booking.v1.ExtendSeatHoldReply
+	42, // 72: booking.v1.BookingService.JoinWaitlist:output_type -> booking.v1.WaitlistEntryReply
+	42, // 73: booking.v1.BookingService.GetWaitlistEntry:output_type -> booking.v1.WaitlistEntryReply
+	42, // 74: booking.v1.BookingService.LeaveWaitlist:output_type -> booking.v1.WaitlistEntryReply
+	9,  // 75: booking.v1.BookingService.AcceptWaitlistOffer:output_type -> booking.v1.CreateBookingReply
+	48, // 76: booking.v1.BookingService.TransferBooking:output_type -> booking.v1.TransferReply
+	48, // 77: booking.v1.BookingService.GetTransfer:output_type -> booking.v1.TransferReply
+	48, // 78: booking.v1.BookingService.AcceptTransfer:output_type -> booking.v1.TransferReply
+	48, // 79: booking.v1.BookingService.CancelTransfer:output_type -> booking.v1.TransferReply
+	50, // 80: booking.v1.BookingService.ListBookingTransfers:output_type -> booking.v1.ListBookingTransfersReply
+	55, // 81: booking.v1.BookingService.ListTickets:output_type -> booking.v1.ListTicketsReply
+	52, // 82: booking.v1.BookingService.GetEvent:output_type -> booking.v1.GetEventReply
+	56, // [56:83] is the sub-list for method output_type
+	29, // [29:56] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_bookingservice_v1_booking_proto_init() }
@@ -1236,13 +4039,14 @@ func file_bookingservice_v1_booking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bookingservice_v1_booking_proto_rawDesc), len(file_bookingservice_v1_booking_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      7,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_bookingservice_v1_booking_proto_goTypes,
 		DependencyIndexes: file_bookingservice_v1_booking_proto_depIdxs,
+		EnumInfos:         file_bookingservice_v1_booking_proto_enumTypes,
 		MessageInfos:      file_bookingservice_v1_booking_proto_msgTypes,
 	}.Build()
 	File_bookingservice_v1_booking_proto = out.File
@@ -19,17 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName  = "/booking.v1.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName     = "/booking.v1.BookingService/GetBooking"
-	BookingService_ListBookings_FullMethodName   = "/booking.v1.BookingService/ListBookings"
-	BookingService_UpdateBooking_FullMethodName  = "/booking.v1.BookingService/UpdateBooking"
-	BookingService_CancelBooking_FullMethodName  = "/booking.v1.BookingService/CancelBooking"
-	BookingService_ConfirmBooking_FullMethodName = "/booking.v1.BookingService/ConfirmBooking"
-	BookingService_GetBookedSeats_FullMethodName = "/booking.v1.BookingService/GetBookedSeats"
-	BookingService_GetLockedSeats_FullMethodName = "/booking.v1.BookingService/GetLockedSeats"
-	BookingService_LockSeat_FullMethodName       = "/booking.v1.BookingService/LockSeat"
-	BookingService_UnlockSeat_FullMethodName     = "/booking.v1.BookingService/UnlockSeat"
-	BookingService_GetEvent_FullMethodName       = "/booking.v1.BookingService/GetEvent"
+	BookingService_CreateBooking_FullMethodName        = "/booking.v1.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName           = "/booking.v1.BookingService/GetBooking"
+	BookingService_GetBookingHistory_FullMethodName    = "/booking.v1.BookingService/GetBookingHistory"
+	BookingService_ListBookings_FullMethodName         = "/booking.v1.BookingService/ListBookings"
+	BookingService_ListMyBookings_FullMethodName       = "/booking.v1.BookingService/ListMyBookings"
+	BookingService_UpdateBooking_FullMethodName        = "/booking.v1.BookingService/UpdateBooking"
+	BookingService_CancelBooking_FullMethodName        = "/booking.v1.BookingService/CancelBooking"
+	BookingService_CancelSeats_FullMethodName          = "/booking.v1.BookingService/CancelSeats"
+	BookingService_ChangeSeats_FullMethodName          = "/booking.v1.BookingService/ChangeSeats"
+	BookingService_ConfirmBooking_FullMethodName       = "/booking.v1.BookingService/ConfirmBooking"
+	BookingService_GetBookedSeats_FullMethodName       = "/booking.v1.BookingService/GetBookedSeats"
+	BookingService_GetLockedSeats_FullMethodName       = "/booking.v1.BookingService/GetLockedSeats"
+	BookingService_WatchSeats_FullMethodName           = "/booking.v1.BookingService/WatchSeats"
+	BookingService_LockSeat_FullMethodName             = "/booking.v1.BookingService/LockSeat"
+	BookingService_UnlockSeat_FullMethodName           = "/booking.v1.BookingService/UnlockSeat"
+	BookingService_ExtendSeatHold_FullMethodName       = "/booking.v1.BookingService/ExtendSeatHold"
+	BookingService_JoinWaitlist_FullMethodName         = "/booking.v1.BookingService/JoinWaitlist"
+	BookingService_GetWaitlistEntry_FullMethodName     = "/booking.v1.BookingService/GetWaitlistEntry"
+	BookingService_LeaveWaitlist_FullMethodName        = "/booking.v1.BookingService/LeaveWaitlist"
+	BookingService_AcceptWaitlistOffer_FullMethodName  = "/booking.v1.BookingService/AcceptWaitlistOffer"
+	BookingService_TransferBooking_FullMethodName      = "/booking.v1.BookingService/TransferBooking"
+	BookingService_GetTransfer_FullMethodName          = "/booking.v1.BookingService/GetTransfer"
+	BookingService_AcceptTransfer_FullMethodName       = "/booking.v1.BookingService/AcceptTransfer"
+	BookingService_CancelTransfer_FullMethodName       = "/booking.v1.BookingService/CancelTransfer"
+	BookingService_ListBookingTransfers_FullMethodName = "/booking.v1.BookingService/ListBookingTransfers"
+	BookingService_ListTickets_FullMethodName          = "/booking.v1.BookingService/ListTickets"
+	BookingService_GetEvent_FullMethodName             = "/booking.v1.BookingService/GetEvent"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -38,14 +54,69 @@ const (
 type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingReply, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*CreateBookingReply, error)
+	// Every status the booking has been in, oldest first, with who moved it
+	// and why. Needs the bearer token of the booking's owner or of a user
+	// with the staff role.
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryReply, error)
 	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsReply, error)
+	// Bookings of the user in the bearer token.
+	ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListBookingsReply, error)
+	// Moves a booking after its payment settles. Only paymentservice calls
+	// it, over gRPC with a service token; it has no HTTP route.
 	UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingReply, error)
+	// Cancels a booking. Needs the booking owner's bearer token.
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CreateBookingReply, error)
+	// Drops some seats of a booking and lowers its total. The seats go back to
+	// the event, and for a CONFIRMED booking their share is refunded. Needs
+	// the booking owner's bearer token.
+	CancelSeats(ctx context.Context, in *CancelSeatsRequest, opts ...grpc.CallOption) (*CancelSeatsReply, error)
+	// Moves a PENDING or CONFIRMED booking from some of its seats to others.
+	// The new seats are held first and swapped in atomically; the price
+	// difference of a CONFIRMED booking is charged or refunded. Needs the
+	// booking owner's bearer token.
+	ChangeSeats(ctx context.Context, in *ChangeSeatsRequest, opts ...grpc.CallOption) (*ChangeSeatsReply, error)
+	// Confirms a booking. Needs the booking owner's bearer token.
 	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*CreateBookingReply, error)
 	GetBookedSeats(ctx context.Context, in *GetBookedSeatsRequest, opts ...grpc.CallOption) (*GetBookedSeatsReply, error)
 	GetLockedSeats(ctx context.Context, in *GetLockedSeatsRequest, opts ...grpc.CallOption) (*GetLockedSeatsReply, error)
+	// Streams seat changes of one event: first the current booked and held
+	// seats, then every hold, release, booking and cancellation as it
+	// happens. The HTTP server serves the same stream as server-sent events
+	// on GET /v1/events/{event_id}/seats/stream.
+	WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SeatUpdate], error)
 	LockSeat(ctx context.Context, in *LockSeatRequest, opts ...grpc.CallOption) (*LockSeatReply, error)
 	UnlockSeat(ctx context.Context, in *UnlockSeatRequest, opts ...grpc.CallOption) (*UnlockSeatReply, error)
+	ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldReply, error)
+	// Queue for seats of a sold-out event. When seats come back the oldest
+	// entries that fit are offered them for a limited time. Needs the
+	// user's bearer token.
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error)
+	GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error)
+	// Leaves the waitlist. Needs the entry owner's bearer token.
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error)
+	// Turns an open offer into a PENDING booking for the chosen seats. Needs
+	// the entry owner's bearer token.
+	AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*CreateBookingReply, error)
+	// Offers a CONFIRMED booking to another registered user, found by email.
+	// The booking changes hands only when the recipient accepts. Needs the
+	// booking owner's bearer token.
+	TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// Needs the bearer token of the sender, the recipient or the booking's
+	// owner.
+	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// Moves the booking to the recipient and issues it a new ticket code.
+	// Needs the recipient's bearer token.
+	AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// Withdraws (sender) or declines (recipient) a pending transfer. Needs
+	// the sender's or the recipient's bearer token.
+	CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	// Every transfer of a booking, oldest first. Needs the booking owner's
+	// bearer token; a sender or recipient sees only their own transfers.
+	ListBookingTransfers(ctx context.Context, in *ListBookingTransfersRequest, opts ...grpc.CallOption) (*ListBookingTransfersReply, error)
+	// One ticket per seat of a booking, issued when it is confirmed. Each
+	// valid ticket carries a signed payload for its QR code. Needs the
+	// booking owner's bearer token.
+	ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsReply, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventReply, error)
 }
 
@@ -77,6 +148,16 @@ func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingReq
 	return out, nil
 }
 
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*GetBookingHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingHistoryReply)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*ListBookingsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBookingsReply)
@@ -87,6 +168,16 @@ func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBooking
 	return out, nil
 }
 
+func (c *bookingServiceClient) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListBookingsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingsReply)
+	err := c.cc.Invoke(ctx, BookingService_ListMyBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateBookingReply)
@@ -107,6 +198,26 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) CancelSeats(ctx context.Context, in *CancelSeatsRequest, opts ...grpc.CallOption) (*CancelSeatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSeatsReply)
+	err := c.cc.Invoke(ctx, BookingService_CancelSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ChangeSeats(ctx context.Context, in *ChangeSeatsRequest, opts ...grpc.CallOption) (*ChangeSeatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeSeatsReply)
+	err := c.cc.Invoke(ctx, BookingService_ChangeSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*CreateBookingReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateBookingReply)
@@ -137,6 +248,25 @@ func (c *bookingServiceClient) GetLockedSeats(ctx context.Context, in *GetLocked
 	return out, nil
 }
 
+func (c *bookingServiceClient) WatchSeats(ctx context.Context, in *WatchSeatsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SeatUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_WatchSeats_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSeatsRequest, SeatUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchSeatsClient = grpc.ServerStreamingClient[SeatUpdate]
+
 func (c *bookingServiceClient) LockSeat(ctx context.Context, in *LockSeatRequest, opts ...grpc.CallOption) (*LockSeatReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LockSeatReply)
@@ -157,6 +287,116 @@ func (c *bookingServiceClient) UnlockSeat(ctx context.Context, in *UnlockSeatReq
 	return out, nil
 }
 
+func (c *bookingServiceClient) ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendSeatHoldReply)
+	err := c.cc.Invoke(ctx, BookingService_ExtendSeatHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntryReply)
+	err := c.cc.Invoke(ctx, BookingService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntryReply)
+	err := c.cc.Invoke(ctx, BookingService_GetWaitlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitlistEntryReply)
+	err := c.cc.Invoke(ctx, BookingService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*CreateBookingReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBookingReply)
+	err := c.cc.Invoke(ctx, BookingService_AcceptWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, BookingService_TransferBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, BookingService_GetTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, BookingService_AcceptTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, BookingService_CancelTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBookingTransfers(ctx context.Context, in *ListBookingTransfersRequest, opts ...grpc.CallOption) (*ListBookingTransfersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingTransfersReply)
+	err := c.cc.Invoke(ctx, BookingService_ListBookingTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...grpc.CallOption) (*ListTicketsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketsReply)
+	err := c.cc.Invoke(ctx, BookingService_ListTickets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetEventReply)
@@ -173,14 +413,69 @@ func (c *bookingServiceClient) GetEvent(ctx context.Context, in *GetEventRequest
 type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingReply, error)
 	GetBooking(context.Context, *GetBookingRequest) (*CreateBookingReply, error)
+	// Every status the booking has been in, oldest first, with who moved it
+	// and why. Needs the bearer token of the booking's owner or of a user
+	// with the staff role.
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryReply, error)
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsReply, error)
+	// Bookings of the user in the bearer token.
+	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListBookingsReply, error)
+	// Moves a booking after its payment settles. Only paymentservice calls
+	// it, over gRPC with a service token; it has no HTTP route.
 	UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingReply, error)
+	// Cancels a booking. Needs the booking owner's bearer token.
 	CancelBooking(context.Context, *CancelBookingRequest) (*CreateBookingReply, error)
+	// Drops some seats of a booking and lowers its total. The seats go back to
+	// the event, and for a CONFIRMED booking their share is refunded. Needs
+	// the booking owner's bearer token.
+	CancelSeats(context.Context, *CancelSeatsRequest) (*CancelSeatsReply, error)
+	// Moves a PENDING or CONFIRMED booking from some of its seats to others.
+	// The new seats are held first and swapped in atomically; the price
+	// difference of a CONFIRMED booking is charged or refunded. Needs the
+	// booking owner's bearer token.
+	ChangeSeats(context.Context, *ChangeSeatsRequest) (*ChangeSeatsReply, error)
+	// Confirms a booking. Needs the booking owner's bearer token.
 	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*CreateBookingReply, error)
 	GetBookedSeats(context.Context, *GetBookedSeatsRequest) (*GetBookedSeatsReply, error)
 	GetLockedSeats(context.Context, *GetLockedSeatsRequest) (*GetLockedSeatsReply, error)
+	// Streams seat changes of one event: first the current booked and held
+	// seats, then every hold, release, booking and cancellation as it
+	// happens. The HTTP server serves the same stream as server-sent events
+	// on GET /v1/events/{event_id}/seats/stream.
+	WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[SeatUpdate]) error
 	LockSeat(context.Context, *LockSeatRequest) (*LockSeatReply, error)
 	UnlockSeat(context.Context, *UnlockSeatRequest) (*UnlockSeatReply, error)
+	ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldReply, error)
+	// Queue for seats of a sold-out event. When seats come back the oldest
+	// entries that fit are offered them for a limited time. Needs the
+	// user's bearer token.
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntryReply, error)
+	GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*WaitlistEntryReply, error)
+	// Leaves the waitlist. Needs the entry owner's bearer token.
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*WaitlistEntryReply, error)
+	// Turns an open offer into a PENDING booking for the chosen seats. Needs
+	// the entry owner's bearer token.
+	AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*CreateBookingReply, error)
+	// Offers a CONFIRMED booking to another registered user, found by email.
+	// The booking changes hands only when the recipient accepts. Needs the
+	// booking owner's bearer token.
+	TransferBooking(context.Context, *TransferBookingRequest) (*TransferReply, error)
+	// Needs the bearer token of the sender, the recipient or the booking's
+	// owner.
+	GetTransfer(context.Context, *GetTransferRequest) (*TransferReply, error)
+	// Moves the booking to the recipient and issues it a new ticket code.
+	// Needs the recipient's bearer token.
+	AcceptTransfer(context.Context, *AcceptTransferRequest) (*TransferReply, error)
+	// Withdraws (sender) or declines (recipient) a pending transfer. Needs
+	// the sender's or the recipient's bearer token.
+	CancelTransfer(context.Context, *CancelTransferRequest) (*TransferReply, error)
+	// Every transfer of a booking, oldest first. Needs the booking owner's
+	// bearer token; a sender or recipient sees only their own transfers.
+	ListBookingTransfers(context.Context, *ListBookingTransfersRequest) (*ListBookingTransfersReply, error)
+	// One ticket per seat of a booking, issued when it is confirmed. Each
+	// valid ticket carries a signed payload for its QR code. Needs the
+	// booking owner's bearer token.
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsReply, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventReply, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
@@ -198,15 +493,27 @@ func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateB
 func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*CreateBookingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
 func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
 }
+func (UnimplementedBookingServiceServer) ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListBookingsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBookings not implemented")
+}
 func (UnimplementedBookingServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBooking not implemented")
 }
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CreateBookingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) CancelSeats(context.Context, *CancelSeatsRequest) (*CancelSeatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSeats not implemented")
+}
+func (UnimplementedBookingServiceServer) ChangeSeats(context.Context, *ChangeSeatsRequest) (*ChangeSeatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSeats not implemented")
+}
 func (UnimplementedBookingServiceServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*CreateBookingReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBooking not implemented")
 }
@@ -216,12 +523,48 @@ func (UnimplementedBookingServiceServer) GetBookedSeats(context.Context, *GetBoo
 func (UnimplementedBookingServiceServer) GetLockedSeats(context.Context, *GetLockedSeatsRequest) (*GetLockedSeatsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLockedSeats not implemented")
 }
+func (UnimplementedBookingServiceServer) WatchSeats(*WatchSeatsRequest, grpc.ServerStreamingServer[SeatUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSeats not implemented")
+}
 func (UnimplementedBookingServiceServer) LockSeat(context.Context, *LockSeatRequest) (*LockSeatReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockSeat not implemented")
 }
 func (UnimplementedBookingServiceServer) UnlockSeat(context.Context, *UnlockSeatRequest) (*UnlockSeatReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockSeat not implemented")
 }
+func (UnimplementedBookingServiceServer) ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendSeatHold not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*WaitlistEntryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistEntry not implemented")
+}
+func (UnimplementedBookingServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*WaitlistEntryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*CreateBookingReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWaitlistOffer not implemented")
+}
+func (UnimplementedBookingServiceServer) TransferBooking(context.Context, *TransferBookingRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetTransfer(context.Context, *GetTransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfer not implemented")
+}
+func (UnimplementedBookingServiceServer) AcceptTransfer(context.Context, *AcceptTransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTransfer not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelTransfer(context.Context, *CancelTransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBookingTransfers(context.Context, *ListBookingTransfersRequest) (*ListBookingTransfersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookingTransfers not implemented")
+}
+func (UnimplementedBookingServiceServer) ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTickets not implemented")
+}
 func (UnimplementedBookingServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
 }
@@ -282,6 +625,24 @@ func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBookingsRequest)
 	if err := dec(in); err != nil {
@@ -300,6 +661,24 @@ func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListMyBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_UpdateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateBookingRequest)
 	if err := dec(in); err != nil {
@@ -336,6 +715,42 @@ func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_CancelSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelSeats(ctx, req.(*CancelSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ChangeSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ChangeSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ChangeSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ChangeSeats(ctx, req.(*ChangeSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConfirmBookingRequest)
 	if err := dec(in); err != nil {
@@ -390,6 +805,17 @@ func _BookingService_GetLockedSeats_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_WatchSeats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSeatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).WatchSeats(m, &grpc.GenericServerStream[WatchSeatsRequest, SeatUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchSeatsServer = grpc.ServerStreamingServer[SeatUpdate]
+
 func _BookingService_LockSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LockSeatRequest)
 	if err := dec(in); err != nil {
@@ -426,6 +852,204 @@ func _BookingService_UnlockSeat_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ExtendSeatHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendSeatHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExtendSeatHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExtendSeatHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExtendSeatHold(ctx, req.(*ExtendSeatHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetWaitlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetWaitlistEntry(ctx, req.(*GetWaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AcceptWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptWaitlistOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AcceptWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AcceptWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AcceptWaitlistOffer(ctx, req.(*AcceptWaitlistOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_TransferBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).TransferBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_TransferBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).TransferBooking(ctx, req.(*TransferBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetTransfer(ctx, req.(*GetTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AcceptTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AcceptTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AcceptTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AcceptTransfer(ctx, req.(*AcceptTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelTransfer(ctx, req.(*CancelTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBookingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBookingTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookingTransfers(ctx, req.(*ListBookingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListTickets(ctx, req.(*ListTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEventRequest)
 	if err := dec(in); err != nil {
@@ -459,10 +1083,18 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBooking",
 			Handler:    _BookingService_GetBooking_Handler,
 		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
 		{
 			MethodName: "ListBookings",
 			Handler:    _BookingService_ListBookings_Handler,
 		},
+		{
+			MethodName: "ListMyBookings",
+			Handler:    _BookingService_ListMyBookings_Handler,
+		},
 		{
 			MethodName: "UpdateBooking",
 			Handler:    _BookingService_UpdateBooking_Handler,
@@ -471,6 +1103,14 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "CancelSeats",
+			Handler:    _BookingService_CancelSeats_Handler,
+		},
+		{
+			MethodName: "ChangeSeats",
+			Handler:    _BookingService_ChangeSeats_Handler,
+		},
 		{
 			MethodName: "ConfirmBooking",
 			Handler:    _BookingService_ConfirmBooking_Handler,
@@ -491,11 +1131,61 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnlockSeat",
 			Handler:    _BookingService_UnlockSeat_Handler,
 		},
+		{
+			MethodName: "ExtendSeatHold",
+			Handler:    _BookingService_ExtendSeatHold_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistEntry",
+			Handler:    _BookingService_GetWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _BookingService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "AcceptWaitlistOffer",
+			Handler:    _BookingService_AcceptWaitlistOffer_Handler,
+		},
+		{
+			MethodName: "TransferBooking",
+			Handler:    _BookingService_TransferBooking_Handler,
+		},
+		{
+			MethodName: "GetTransfer",
+			Handler:    _BookingService_GetTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTransfer",
+			Handler:    _BookingService_AcceptTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _BookingService_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "ListBookingTransfers",
+			Handler:    _BookingService_ListBookingTransfers_Handler,
+		},
+		{
+			MethodName: "ListTickets",
+			Handler:    _BookingService_ListTickets_Handler,
+		},
 		{
 			MethodName: "GetEvent",
 			Handler:    _BookingService_GetEvent_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSeats",
+			Handler:       _BookingService_WatchSeats_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "bookingservice/v1/booking.proto",
 }
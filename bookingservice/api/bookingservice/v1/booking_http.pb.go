@@ -19,44 +19,119 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationBookingServiceAcceptTransfer = "/booking.v1.BookingService/AcceptTransfer"
+const OperationBookingServiceAcceptWaitlistOffer = "/booking.v1.BookingService/AcceptWaitlistOffer"
 const OperationBookingServiceCancelBooking = "/booking.v1.BookingService/CancelBooking"
+const OperationBookingServiceCancelSeats = "/booking.v1.BookingService/CancelSeats"
+const OperationBookingServiceCancelTransfer = "/booking.v1.BookingService/CancelTransfer"
+const OperationBookingServiceChangeSeats = "/booking.v1.BookingService/ChangeSeats"
 const OperationBookingServiceConfirmBooking = "/booking.v1.BookingService/ConfirmBooking"
 const OperationBookingServiceCreateBooking = "/booking.v1.BookingService/CreateBooking"
+const OperationBookingServiceExtendSeatHold = "/booking.v1.BookingService/ExtendSeatHold"
 const OperationBookingServiceGetBookedSeats = "/booking.v1.BookingService/GetBookedSeats"
 const OperationBookingServiceGetBooking = "/booking.v1.BookingService/GetBooking"
+const OperationBookingServiceGetBookingHistory = "/booking.v1.BookingService/GetBookingHistory"
 const OperationBookingServiceGetEvent = "/booking.v1.BookingService/GetEvent"
 const OperationBookingServiceGetLockedSeats = "/booking.v1.BookingService/GetLockedSeats"
+const OperationBookingServiceGetTransfer = "/booking.v1.BookingService/GetTransfer"
+const OperationBookingServiceGetWaitlistEntry = "/booking.v1.BookingService/GetWaitlistEntry"
+const OperationBookingServiceJoinWaitlist = "/booking.v1.BookingService/JoinWaitlist"
+const OperationBookingServiceLeaveWaitlist = "/booking.v1.BookingService/LeaveWaitlist"
+const OperationBookingServiceListBookingTransfers = "/booking.v1.BookingService/ListBookingTransfers"
 const OperationBookingServiceListBookings = "/booking.v1.BookingService/ListBookings"
+const OperationBookingServiceListMyBookings = "/booking.v1.BookingService/ListMyBookings"
+const OperationBookingServiceListTickets = "/booking.v1.BookingService/ListTickets"
 const OperationBookingServiceLockSeat = "/booking.v1.BookingService/LockSeat"
+const OperationBookingServiceTransferBooking = "/booking.v1.BookingService/TransferBooking"
 const OperationBookingServiceUnlockSeat = "/booking.v1.BookingService/UnlockSeat"
-const OperationBookingServiceUpdateBooking = "/booking.v1.BookingService/UpdateBooking"
 
 type BookingServiceHTTPServer interface {
+	// AcceptTransfer Moves the booking to the recipient and issues it a new ticket code.
+	// Needs the recipient's bearer token.
+	AcceptTransfer(context.Context, *AcceptTransferRequest) (*TransferReply, error)
+	// AcceptWaitlistOffer Turns an open offer into a PENDING booking for the chosen seats. Needs
+	// the entry owner's bearer token.
+	AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*CreateBookingReply, error)
+	// CancelBooking Cancels a booking. Needs the booking owner's bearer token.
 	CancelBooking(context.Context, *CancelBookingRequest) (*CreateBookingReply, error)
+	// CancelSeats Drops some seats of a booking and lowers its total. The seats go back to
+	// the event, and for a CONFIRMED booking their share is refunded. Needs
+	// the booking owner's bearer token.
+	CancelSeats(context.Context, *CancelSeatsRequest) (*CancelSeatsReply, error)
+	// CancelTransfer Withdraws (sender) or declines (recipient) a pending transfer. Needs
+	// the sender's or the recipient's bearer token.
+	CancelTransfer(context.Context, *CancelTransferRequest) (*TransferReply, error)
+	// ChangeSeats Moves a PENDING or CONFIRMED booking from some of its seats to others.
+	// The new seats are held first and swapped in atomically; the price
+	// difference of a CONFIRMED booking is charged or refunded. Needs the
+	// booking owner's bearer token.
+	ChangeSeats(context.Context, *ChangeSeatsRequest) (*ChangeSeatsReply, error)
+	// ConfirmBooking Confirms a booking. Needs the booking owner's bearer token.
 	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*CreateBookingReply, error)
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingReply, error)
+	ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldReply, error)
 	GetBookedSeats(context.Context, *GetBookedSeatsRequest) (*GetBookedSeatsReply, error)
 	GetBooking(context.Context, *GetBookingRequest) (*CreateBookingReply, error)
+	// GetBookingHistory Every status the booking has been in, oldest first, with who moved it
+	// and why. Needs the bearer token of the booking's owner or of a user
+	// with the staff role.
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*GetBookingHistoryReply, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventReply, error)
 	GetLockedSeats(context.Context, *GetLockedSeatsRequest) (*GetLockedSeatsReply, error)
+	// GetTransfer Needs the bearer token of the sender, the recipient or the booking's
+	// owner.
+	GetTransfer(context.Context, *GetTransferRequest) (*TransferReply, error)
+	GetWaitlistEntry(context.Context, *GetWaitlistEntryRequest) (*WaitlistEntryReply, error)
+	// JoinWaitlist Queue for seats of a sold-out event. When seats come back the oldest
+	// entries that fit are offered them for a limited time. Needs the
+	// user's bearer token.
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntryReply, error)
+	// LeaveWaitlist Leaves the waitlist. Needs the entry owner's bearer token.
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*WaitlistEntryReply, error)
+	// ListBookingTransfers Every transfer of a booking, oldest first. Needs the booking owner's
+	// bearer token; a sender or recipient sees only their own transfers.
+	ListBookingTransfers(context.Context, *ListBookingTransfersRequest) (*ListBookingTransfersReply, error)
 	ListBookings(context.Context, *ListBookingsRequest) (*ListBookingsReply, error)
+	// ListMyBookings Bookings of the user in the bearer token.
+	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListBookingsReply, error)
+	// ListTickets One ticket per seat of a booking, issued when it is confirmed. Each
+	// valid ticket carries a signed payload for its QR code. Needs the
+	// booking owner's bearer token.
+	ListTickets(context.Context, *ListTicketsRequest) (*ListTicketsReply, error)
 	LockSeat(context.Context, *LockSeatRequest) (*LockSeatReply, error)
+	// TransferBooking Offers a CONFIRMED booking to another registered user, found by email.
+	// The booking changes hands only when the recipient accepts. Needs the
+	// booking owner's bearer token.
+	TransferBooking(context.Context, *TransferBookingRequest) (*TransferReply, error)
 	UnlockSeat(context.Context, *UnlockSeatRequest) (*UnlockSeatReply, error)
-	UpdateBooking(context.Context, *UpdateBookingRequest) (*UpdateBookingReply, error)
 }
 
 func RegisterBookingServiceHTTPServer(s *http.Server, srv BookingServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/bookings", _BookingService_CreateBooking0_HTTP_Handler(srv))
 	r.GET("/v1/bookings/{id}", _BookingService_GetBooking0_HTTP_Handler(srv))
+	r.GET("/v1/bookings/{id}/history", _BookingService_GetBookingHistory0_HTTP_Handler(srv))
 	r.GET("/v1/bookings", _BookingService_ListBookings0_HTTP_Handler(srv))
-	r.PATCH("/v1/bookings/{id}", _BookingService_UpdateBooking0_HTTP_Handler(srv))
+	r.GET("/v1/me/bookings", _BookingService_ListMyBookings0_HTTP_Handler(srv))
 	r.PUT("/v1/bookings/{id}", _BookingService_CancelBooking0_HTTP_Handler(srv))
+	r.POST("/v1/bookings/{id}/cancel-seats", _BookingService_CancelSeats0_HTTP_Handler(srv))
+	r.POST("/v1/bookings/{id}/change-seats", _BookingService_ChangeSeats0_HTTP_Handler(srv))
 	r.PUT("/v1/bookings/{id}/confirm", _BookingService_ConfirmBooking0_HTTP_Handler(srv))
 	r.GET("/events/{event_id}/booked-seats", _BookingService_GetBookedSeats0_HTTP_Handler(srv))
 	r.GET("/events/{event_id}/locked-seats", _BookingService_GetLockedSeats0_HTTP_Handler(srv))
 	r.POST("/v1/events/{event_id}/lock-seat", _BookingService_LockSeat0_HTTP_Handler(srv))
 	r.POST("/v1/events/{event_id}/unlock-seat", _BookingService_UnlockSeat0_HTTP_Handler(srv))
+	r.POST("/v1/events/{event_id}/extend-seat-hold", _BookingService_ExtendSeatHold0_HTTP_Handler(srv))
+	r.POST("/v1/events/{event_id}/waitlist", _BookingService_JoinWaitlist0_HTTP_Handler(srv))
+	r.GET("/v1/waitlist/{id}", _BookingService_GetWaitlistEntry0_HTTP_Handler(srv))
+	r.POST("/v1/waitlist/{id}/leave", _BookingService_LeaveWaitlist0_HTTP_Handler(srv))
+	r.POST("/v1/waitlist/{id}/accept", _BookingService_AcceptWaitlistOffer0_HTTP_Handler(srv))
+	r.POST("/v1/bookings/{id}/transfers", _BookingService_TransferBooking0_HTTP_Handler(srv))
+	r.GET("/v1/transfers/{id}", _BookingService_GetTransfer0_HTTP_Handler(srv))
+	r.POST("/v1/transfers/{id}/accept", _BookingService_AcceptTransfer0_HTTP_Handler(srv))
+	r.POST("/v1/transfers/{id}/cancel", _BookingService_CancelTransfer0_HTTP_Handler(srv))
+	r.GET("/v1/bookings/{id}/transfers", _BookingService_ListBookingTransfers0_HTTP_Handler(srv))
+	r.GET("/v1/bookings/{id}/tickets", _BookingService_ListTickets0_HTTP_Handler(srv))
 	r.GET("/v1/events/{id}", _BookingService_GetEvent0_HTTP_Handler(srv))
 }
 
@@ -104,6 +179,28 @@ func _BookingService_GetBooking0_HTTP_Handler(srv BookingServiceHTTPServer) func
 	}
 }
 
+func _BookingService_GetBookingHistory0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetBookingHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceGetBookingHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetBookingHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _BookingService_ListBookings0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in ListBookingsRequest
@@ -123,9 +220,28 @@ func _BookingService_ListBookings0_HTTP_Handler(srv BookingServiceHTTPServer) fu
 	}
 }
 
-func _BookingService_UpdateBooking0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+func _BookingService_ListMyBookings0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyBookingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceListMyBookings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBookingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_CancelBooking0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateBookingRequest
+		var in CancelBookingRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -135,22 +251,22 @@ func _BookingService_UpdateBooking0_HTTP_Handler(srv BookingServiceHTTPServer) f
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBookingServiceUpdateBooking)
+		http.SetOperation(ctx, OperationBookingServiceCancelBooking)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateBooking(ctx, req.(*UpdateBookingRequest))
+			return srv.CancelBooking(ctx, req.(*CancelBookingRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UpdateBookingReply)
+		reply := out.(*CreateBookingReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _BookingService_CancelBooking0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+func _BookingService_CancelSeats0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CancelBookingRequest
+		var in CancelSeatsRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
@@ -160,15 +276,40 @@ func _BookingService_CancelBooking0_HTTP_Handler(srv BookingServiceHTTPServer) f
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationBookingServiceCancelBooking)
+		http.SetOperation(ctx, OperationBookingServiceCancelSeats)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CancelBooking(ctx, req.(*CancelBookingRequest))
+			return srv.CancelSeats(ctx, req.(*CancelSeatsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateBookingReply)
+		reply := out.(*CancelSeatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_ChangeSeats0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangeSeatsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceChangeSeats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangeSeats(ctx, req.(*ChangeSeatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangeSeatsReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -292,6 +433,269 @@ func _BookingService_UnlockSeat0_HTTP_Handler(srv BookingServiceHTTPServer) func
 	}
 }
 
+func _BookingService_ExtendSeatHold0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExtendSeatHoldRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceExtendSeatHold)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExtendSeatHold(ctx, req.(*ExtendSeatHoldRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExtendSeatHoldReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_JoinWaitlist0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in JoinWaitlistRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceJoinWaitlist)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WaitlistEntryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_GetWaitlistEntry0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetWaitlistEntryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceGetWaitlistEntry)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetWaitlistEntry(ctx, req.(*GetWaitlistEntryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WaitlistEntryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_LeaveWaitlist0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LeaveWaitlistRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceLeaveWaitlist)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*WaitlistEntryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_AcceptWaitlistOffer0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptWaitlistOfferRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceAcceptWaitlistOffer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptWaitlistOffer(ctx, req.(*AcceptWaitlistOfferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateBookingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_TransferBooking0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferBookingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceTransferBooking)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TransferBooking(ctx, req.(*TransferBookingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_GetTransfer0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTransferRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceGetTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTransfer(ctx, req.(*GetTransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_AcceptTransfer0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AcceptTransferRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceAcceptTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AcceptTransfer(ctx, req.(*AcceptTransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_CancelTransfer0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CancelTransferRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceCancelTransfer)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelTransfer(ctx, req.(*CancelTransferRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TransferReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_ListBookingTransfers0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListBookingTransfersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceListBookingTransfers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListBookingTransfers(ctx, req.(*ListBookingTransfersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListBookingTransfersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _BookingService_ListTickets0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTicketsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationBookingServiceListTickets)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTickets(ctx, req.(*ListTicketsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTicketsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _BookingService_GetEvent0_HTTP_Handler(srv BookingServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetEventRequest
@@ -315,17 +719,64 @@ func _BookingService_GetEvent0_HTTP_Handler(srv BookingServiceHTTPServer) func(c
 }
 
 type BookingServiceHTTPClient interface {
+	// AcceptTransfer Moves the booking to the recipient and issues it a new ticket code.
+	// Needs the recipient's bearer token.
+	AcceptTransfer(ctx context.Context, req *AcceptTransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
+	// AcceptWaitlistOffer Turns an open offer into a PENDING booking for the chosen seats. Needs
+	// the entry owner's bearer token.
+	AcceptWaitlistOffer(ctx context.Context, req *AcceptWaitlistOfferRequest, opts ...http.CallOption) (rsp *CreateBookingReply, err error)
+	// CancelBooking Cancels a booking. Needs the booking owner's bearer token.
 	CancelBooking(ctx context.Context, req *CancelBookingRequest, opts ...http.CallOption) (rsp *CreateBookingReply, err error)
+	// CancelSeats Drops some seats of a booking and lowers its total. The seats go back to
+	// the event, and for a CONFIRMED booking their share is refunded. Needs
+	// the booking owner's bearer token.
+	CancelSeats(ctx context.Context, req *CancelSeatsRequest, opts ...http.CallOption) (rsp *CancelSeatsReply, err error)
+	// CancelTransfer Withdraws (sender) or declines (recipient) a pending transfer. Needs
+	// the sender's or the recipient's bearer token.
+	CancelTransfer(ctx context.Context, req *CancelTransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
+	// ChangeSeats Moves a PENDING or CONFIRMED booking from some of its seats to others.
+	// The new seats are held first and swapped in atomically; the price
+	// difference of a CONFIRMED booking is charged or refunded. Needs the
+	// booking owner's bearer token.
+	ChangeSeats(ctx context.Context, req *ChangeSeatsRequest, opts ...http.CallOption) (rsp *ChangeSeatsReply, err error)
+	// ConfirmBooking Confirms a booking. Needs the booking owner's bearer token.
 	ConfirmBooking(ctx context.Context, req *ConfirmBookingRequest, opts ...http.CallOption) (rsp *CreateBookingReply, err error)
 	CreateBooking(ctx context.Context, req *CreateBookingRequest, opts ...http.CallOption) (rsp *CreateBookingReply, err error)
+	ExtendSeatHold(ctx context.Context, req *ExtendSeatHoldRequest, opts ...http.CallOption) (rsp *ExtendSeatHoldReply, err error)
 	GetBookedSeats(ctx context.Context, req *GetBookedSeatsRequest, opts ...http.CallOption) (rsp *GetBookedSeatsReply, err error)
 	GetBooking(ctx context.Context, req *GetBookingRequest, opts ...http.CallOption) (rsp *CreateBookingReply, err error)
+	// GetBookingHistory Every status the booking has been in, oldest first, with who moved it
+	// and why. Needs the bearer token of the booking's owner or of a user
+	// with the staff role.
+	GetBookingHistory(ctx context.Context, req *GetBookingHistoryRequest, opts ...http.CallOption) (rsp *GetBookingHistoryReply, err error)
 	GetEvent(ctx context.Context, req *GetEventRequest, opts ...http.CallOption) (rsp *GetEventReply, err error)
 	GetLockedSeats(ctx context.Context, req *GetLockedSeatsRequest, opts ...http.CallOption) (rsp *GetLockedSeatsReply, err error)
+	// GetTransfer Needs the bearer token of the sender, the recipient or the booking's
+	// owner.
+	GetTransfer(ctx context.Context, req *GetTransferRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
+	GetWaitlistEntry(ctx context.Context, req *GetWaitlistEntryRequest, opts ...http.CallOption) (rsp *WaitlistEntryReply, err error)
+	// JoinWaitlist Queue for seats of a sold-out event. When seats come back the oldest
+	// entries that fit are offered them for a limited time. Needs the
+	// user's bearer token.
+	JoinWaitlist(ctx context.Context, req *JoinWaitlistRequest, opts ...http.CallOption) (rsp *WaitlistEntryReply, err error)
+	// LeaveWaitlist Leaves the waitlist. Needs the entry owner's bearer token.
+	LeaveWaitlist(ctx context.Context, req *LeaveWaitlistRequest, opts ...http.CallOption) (rsp *WaitlistEntryReply, err error)
+	// ListBookingTransfers Every transfer of a booking, oldest first. Needs the booking owner's
+	// bearer token; a sender or recipient sees only their own transfers.
+	ListBookingTransfers(ctx context.Context, req *ListBookingTransfersRequest, opts ...http.CallOption) (rsp *ListBookingTransfersReply, err error)
 	ListBookings(ctx context.Context, req *ListBookingsRequest, opts ...http.CallOption) (rsp *ListBookingsReply, err error)
+	// ListMyBookings Bookings of the user in the bearer token.
+	ListMyBookings(ctx context.Context, req *ListMyBookingsRequest, opts ...http.CallOption) (rsp *ListBookingsReply, err error)
+	// ListTickets One ticket per seat of a booking, issued when it is confirmed. Each
+	// valid ticket carries a signed payload for its QR code. Needs the
+	// booking owner's bearer token.
+	ListTickets(ctx context.Context, req *ListTicketsRequest, opts ...http.CallOption) (rsp *ListTicketsReply, err error)
 	LockSeat(ctx context.Context, req *LockSeatRequest, opts ...http.CallOption) (rsp *LockSeatReply, err error)
+	// TransferBooking Offers a CONFIRMED booking to another registered user, found by email.
+	// The booking changes hands only when the recipient accepts. Needs the
+	// booking owner's bearer token.
+	TransferBooking(ctx context.Context, req *TransferBookingRequest, opts ...http.CallOption) (rsp *TransferReply, err error)
 	UnlockSeat(ctx context.Context, req *UnlockSeatRequest, opts ...http.CallOption) (rsp *UnlockSeatReply, err error)
-	UpdateBooking(ctx context.Context, req *UpdateBookingRequest, opts ...http.CallOption) (rsp *UpdateBookingReply, err error)
 }
 
 type BookingServiceHTTPClientImpl struct {
@@ -336,6 +787,37 @@ func NewBookingServiceHTTPClient(client *http.Client) BookingServiceHTTPClient {
 	return &BookingServiceHTTPClientImpl{client}
 }
 
+// AcceptTransfer Moves the booking to the recipient and issues it a new ticket code.
+// Needs the recipient's bearer token.
+func (c *BookingServiceHTTPClientImpl) AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/v1/transfers/{id}/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceAcceptTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AcceptWaitlistOffer Turns an open offer into a PENDING booking for the chosen seats. Needs
+// the entry owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...http.CallOption) (*CreateBookingReply, error) {
+	var out CreateBookingReply
+	pattern := "/v1/waitlist/{id}/accept"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceAcceptWaitlistOffer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelBooking Cancels a booking. Needs the booking owner's bearer token.
 func (c *BookingServiceHTTPClientImpl) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...http.CallOption) (*CreateBookingReply, error) {
 	var out CreateBookingReply
 	pattern := "/v1/bookings/{id}"
@@ -349,6 +831,55 @@ func (c *BookingServiceHTTPClientImpl) CancelBooking(ctx context.Context, in *Ca
 	return &out, nil
 }
 
+// CancelSeats Drops some seats of a booking and lowers its total. The seats go back to
+// the event, and for a CONFIRMED booking their share is refunded. Needs
+// the booking owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) CancelSeats(ctx context.Context, in *CancelSeatsRequest, opts ...http.CallOption) (*CancelSeatsReply, error) {
+	var out CancelSeatsReply
+	pattern := "/v1/bookings/{id}/cancel-seats"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceCancelSeats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelTransfer Withdraws (sender) or declines (recipient) a pending transfer. Needs
+// the sender's or the recipient's bearer token.
+func (c *BookingServiceHTTPClientImpl) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/v1/transfers/{id}/cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceCancelTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangeSeats Moves a PENDING or CONFIRMED booking from some of its seats to others.
+// The new seats are held first and swapped in atomically; the price
+// difference of a CONFIRMED booking is charged or refunded. Needs the
+// booking owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) ChangeSeats(ctx context.Context, in *ChangeSeatsRequest, opts ...http.CallOption) (*ChangeSeatsReply, error) {
+	var out ChangeSeatsReply
+	pattern := "/v1/bookings/{id}/change-seats"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceChangeSeats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmBooking Confirms a booking. Needs the booking owner's bearer token.
 func (c *BookingServiceHTTPClientImpl) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...http.CallOption) (*CreateBookingReply, error) {
 	var out CreateBookingReply
 	pattern := "/v1/bookings/{id}/confirm"
@@ -375,6 +906,19 @@ func (c *BookingServiceHTTPClientImpl) CreateBooking(ctx context.Context, in *Cr
 	return &out, nil
 }
 
+func (c *BookingServiceHTTPClientImpl) ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...http.CallOption) (*ExtendSeatHoldReply, error) {
+	var out ExtendSeatHoldReply
+	pattern := "/v1/events/{event_id}/extend-seat-hold"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceExtendSeatHold))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BookingServiceHTTPClientImpl) GetBookedSeats(ctx context.Context, in *GetBookedSeatsRequest, opts ...http.CallOption) (*GetBookedSeatsReply, error) {
 	var out GetBookedSeatsReply
 	pattern := "/events/{event_id}/booked-seats"
@@ -401,6 +945,22 @@ func (c *BookingServiceHTTPClientImpl) GetBooking(ctx context.Context, in *GetBo
 	return &out, nil
 }
 
+// GetBookingHistory Every status the booking has been in, oldest first, with who moved it
+// and why. Needs the bearer token of the booking's owner or of a user
+// with the staff role.
+func (c *BookingServiceHTTPClientImpl) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...http.CallOption) (*GetBookingHistoryReply, error) {
+	var out GetBookingHistoryReply
+	pattern := "/v1/bookings/{id}/history"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceGetBookingHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BookingServiceHTTPClientImpl) GetEvent(ctx context.Context, in *GetEventRequest, opts ...http.CallOption) (*GetEventReply, error) {
 	var out GetEventReply
 	pattern := "/v1/events/{id}"
@@ -427,6 +987,79 @@ func (c *BookingServiceHTTPClientImpl) GetLockedSeats(ctx context.Context, in *G
 	return &out, nil
 }
 
+// GetTransfer Needs the bearer token of the sender, the recipient or the booking's
+// owner.
+func (c *BookingServiceHTTPClientImpl) GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/v1/transfers/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceGetTransfer))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *BookingServiceHTTPClientImpl) GetWaitlistEntry(ctx context.Context, in *GetWaitlistEntryRequest, opts ...http.CallOption) (*WaitlistEntryReply, error) {
+	var out WaitlistEntryReply
+	pattern := "/v1/waitlist/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceGetWaitlistEntry))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// JoinWaitlist Queue for seats of a sold-out event. When seats come back the oldest
+// entries that fit are offered them for a limited time. Needs the
+// user's bearer token.
+func (c *BookingServiceHTTPClientImpl) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...http.CallOption) (*WaitlistEntryReply, error) {
+	var out WaitlistEntryReply
+	pattern := "/v1/events/{event_id}/waitlist"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceJoinWaitlist))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LeaveWaitlist Leaves the waitlist. Needs the entry owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...http.CallOption) (*WaitlistEntryReply, error) {
+	var out WaitlistEntryReply
+	pattern := "/v1/waitlist/{id}/leave"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationBookingServiceLeaveWaitlist))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBookingTransfers Every transfer of a booking, oldest first. Needs the booking owner's
+// bearer token; a sender or recipient sees only their own transfers.
+func (c *BookingServiceHTTPClientImpl) ListBookingTransfers(ctx context.Context, in *ListBookingTransfersRequest, opts ...http.CallOption) (*ListBookingTransfersReply, error) {
+	var out ListBookingTransfersReply
+	pattern := "/v1/bookings/{id}/transfers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceListBookingTransfers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BookingServiceHTTPClientImpl) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...http.CallOption) (*ListBookingsReply, error) {
 	var out ListBookingsReply
 	pattern := "/v1/bookings"
@@ -440,6 +1073,36 @@ func (c *BookingServiceHTTPClientImpl) ListBookings(ctx context.Context, in *Lis
 	return &out, nil
 }
 
+// ListMyBookings Bookings of the user in the bearer token.
+func (c *BookingServiceHTTPClientImpl) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...http.CallOption) (*ListBookingsReply, error) {
+	var out ListBookingsReply
+	pattern := "/v1/me/bookings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceListMyBookings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTickets One ticket per seat of a booking, issued when it is confirmed. Each
+// valid ticket carries a signed payload for its QR code. Needs the
+// booking owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) ListTickets(ctx context.Context, in *ListTicketsRequest, opts ...http.CallOption) (*ListTicketsReply, error) {
+	var out ListTicketsReply
+	pattern := "/v1/bookings/{id}/tickets"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationBookingServiceListTickets))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *BookingServiceHTTPClientImpl) LockSeat(ctx context.Context, in *LockSeatRequest, opts ...http.CallOption) (*LockSeatReply, error) {
 	var out LockSeatReply
 	pattern := "/v1/events/{event_id}/lock-seat"
@@ -453,11 +1116,14 @@ func (c *BookingServiceHTTPClientImpl) LockSeat(ctx context.Context, in *LockSea
 	return &out, nil
 }
 
-func (c *BookingServiceHTTPClientImpl) UnlockSeat(ctx context.Context, in *UnlockSeatRequest, opts ...http.CallOption) (*UnlockSeatReply, error) {
-	var out UnlockSeatReply
-	pattern := "/v1/events/{event_id}/unlock-seat"
+// TransferBooking Offers a CONFIRMED booking to another registered user, found by email.
+// The booking changes hands only when the recipient accepts. Needs the
+// booking owner's bearer token.
+func (c *BookingServiceHTTPClientImpl) TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...http.CallOption) (*TransferReply, error) {
+	var out TransferReply
+	pattern := "/v1/bookings/{id}/transfers"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBookingServiceUnlockSeat))
+	opts = append(opts, http.Operation(OperationBookingServiceTransferBooking))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
@@ -466,13 +1132,13 @@ func (c *BookingServiceHTTPClientImpl) UnlockSeat(ctx context.Context, in *Unloc
 	return &out, nil
 }
 
-func (c *BookingServiceHTTPClientImpl) UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...http.CallOption) (*UpdateBookingReply, error) {
-	var out UpdateBookingReply
-	pattern := "/v1/bookings/{id}"
+func (c *BookingServiceHTTPClientImpl) UnlockSeat(ctx context.Context, in *UnlockSeatRequest, opts ...http.CallOption) (*UnlockSeatReply, error) {
+	var out UnlockSeatReply
+	pattern := "/v1/events/{event_id}/unlock-seat"
 	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationBookingServiceUpdateBooking))
+	opts = append(opts, http.Operation(OperationBookingServiceUnlockSeat))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "PATCH", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
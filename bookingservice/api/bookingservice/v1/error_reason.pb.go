@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: bookingservice/v1/error_reason.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_GREETER_UNSPECIFIED ErrorReason = 0
+	ErrorReason_USER_NOT_FOUND      ErrorReason = 1
+	// A seat is held by another user, or under a different hold token.
+	ErrorReason_SEAT_HOLD_NOT_OWNED ErrorReason = 2
+	// One or more requested seats are already held or booked.
+	ErrorReason_SEATS_UNAVAILABLE ErrorReason = 3
+	// A seat hold has used up its extensions or its maximum length.
+	ErrorReason_HOLD_LIMIT_REACHED ErrorReason = 4
+	// The booking's current status does not allow the requested change.
+	ErrorReason_INVALID_STATUS_TRANSITION ErrorReason = 5
+	// An idempotency key was reused with a different request body.
+	ErrorReason_IDEMPOTENCY_KEY_REUSED ErrorReason = 6
+	// The first request with this idempotency key is still being processed.
+	ErrorReason_IDEMPOTENCY_REQUEST_IN_PROGRESS ErrorReason = 7
+	// A list filter or page token could not be used.
+	ErrorReason_INVALID_LIST_REQUEST ErrorReason = 8
+	// The user already has an open entry on this event's waitlist.
+	ErrorReason_ALREADY_ON_WAITLIST ErrorReason = 9
+	// The waitlist entry has no open offer: none was made, it lapsed, or it
+	// was already accepted.
+	ErrorReason_WAITLIST_OFFER_UNAVAILABLE ErrorReason = 10
+	// The waitlist entry belongs to another user.
+	ErrorReason_WAITLIST_ENTRY_NOT_OWNED ErrorReason = 11
+	// The booking already has a transfer waiting to be accepted.
+	ErrorReason_TRANSFER_ALREADY_PENDING ErrorReason = 12
+	// The transfer is no longer pending: it was accepted, cancelled or lapsed.
+	ErrorReason_TRANSFER_UNAVAILABLE ErrorReason = 13
+	// The caller is not a party to the transfer, or does not own the booking.
+	ErrorReason_TRANSFER_NOT_ALLOWED ErrorReason = 14
+	// No registered user has the recipient email.
+	ErrorReason_RECIPIENT_NOT_FOUND ErrorReason = 15
+	// The booking belongs to another user.
+	ErrorReason_BOOKING_NOT_OWNED ErrorReason = 16
+	// No ticket has the scanned code, or the payload's signature is bad.
+	ErrorReason_TICKET_NOT_FOUND ErrorReason = 17
+	// The ticket was already scanned; metadata has checked_in_at and gate.
+	ErrorReason_TICKET_ALREADY_USED ErrorReason = 18
+	// The ticket is VOID, its booking is not CONFIRMED, or it is for another event.
+	ErrorReason_TICKET_NOT_VALID ErrorReason = 19
+	// The event's check-in window is not open.
+	ErrorReason_CHECK_IN_CLOSED ErrorReason = 20
+	// No promo code has the given code.
+	ErrorReason_PROMO_CODE_NOT_FOUND ErrorReason = 21
+	// The promo code cannot be used for this booking; metadata has the reason:
+	// not_started, expired, wrong_event, wrong_currency, used_up or
+	// user_limit_reached.
+	ErrorReason_PROMO_CODE_NOT_APPLICABLE ErrorReason = 22
+	// A promo code with the same code already exists.
+	ErrorReason_PROMO_CODE_ALREADY_EXISTS ErrorReason = 23
+	// The user would hold or book more seats of the event than it allows;
+	// metadata has limit, current and requested.
+	ErrorReason_SEAT_LIMIT_EXCEEDED ErrorReason = 24
+	// The event has a waiting room and the caller has no valid admission
+	// token for it; metadata has the reason: missing, invalid or expired.
+	ErrorReason_ADMISSION_REQUIRED ErrorReason = 25
+	// The user is not in the event's queue, or their admission lapsed.
+	ErrorReason_NOT_IN_QUEUE ErrorReason = 26
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "GREETER_UNSPECIFIED",
+		1:  "USER_NOT_FOUND",
+		2:  "SEAT_HOLD_NOT_OWNED",
+		3:  "SEATS_UNAVAILABLE",
+		4:  "HOLD_LIMIT_REACHED",
+		5:  "INVALID_STATUS_TRANSITION",
+		6:  "IDEMPOTENCY_KEY_REUSED",
+		7:  "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+		8:  "INVALID_LIST_REQUEST",
+		9:  "ALREADY_ON_WAITLIST",
+		10: "WAITLIST_OFFER_UNAVAILABLE",
+		11: "WAITLIST_ENTRY_NOT_OWNED",
+		12: "TRANSFER_ALREADY_PENDING",
+		13: "TRANSFER_UNAVAILABLE",
+		14: "TRANSFER_NOT_ALLOWED",
+		15: "RECIPIENT_NOT_FOUND",
+		16: "BOOKING_NOT_OWNED",
+		17: "TICKET_NOT_FOUND",
+		18: "TICKET_ALREADY_USED",
+		19: "TICKET_NOT_VALID",
+		20: "CHECK_IN_CLOSED",
+		21: "PROMO_CODE_NOT_FOUND",
+		22: "PROMO_CODE_NOT_APPLICABLE",
+		23: "PROMO_CODE_ALREADY_EXISTS",
+		24: "SEAT_LIMIT_EXCEEDED",
+		25: "ADMISSION_REQUIRED",
+		26: "NOT_IN_QUEUE",
+	}
+	ErrorReason_value = map[string]int32{
+		"GREETER_UNSPECIFIED":             0,
+		"USER_NOT_FOUND":                  1,
+		"SEAT_HOLD_NOT_OWNED":             2,
+		"SEATS_UNAVAILABLE":               3,
+		"HOLD_LIMIT_REACHED":              4,
+		"INVALID_STATUS_TRANSITION":       5,
+		"IDEMPOTENCY_KEY_REUSED":          6,
+		"IDEMPOTENCY_REQUEST_IN_PROGRESS": 7,
+		"INVALID_LIST_REQUEST":            8,
+		"ALREADY_ON_WAITLIST":             9,
+		"WAITLIST_OFFER_UNAVAILABLE":      10,
+		"WAITLIST_ENTRY_NOT_OWNED":        11,
+		"TRANSFER_ALREADY_PENDING":        12,
+		"TRANSFER_UNAVAILABLE":            13,
+		"TRANSFER_NOT_ALLOWED":            14,
+		"RECIPIENT_NOT_FOUND":             15,
+		"BOOKING_NOT_OWNED":               16,
+		"TICKET_NOT_FOUND":                17,
+		"TICKET_ALREADY_USED":             18,
+		"TICKET_NOT_VALID":                19,
+		"CHECK_IN_CLOSED":                 20,
+		"PROMO_CODE_NOT_FOUND":            21,
+		"PROMO_CODE_NOT_APPLICABLE":       22,
+		"PROMO_CODE_ALREADY_EXISTS":       23,
+		"SEAT_LIMIT_EXCEEDED":             24,
+		"ADMISSION_REQUIRED":              25,
+		"NOT_IN_QUEUE":                    26,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_bookingservice_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_bookingservice_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_bookingservice_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_bookingservice_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_bookingservice_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	"$bookingservice/v1/error_reason.proto\x12\x11bookingservice.v1\x1a\x13errors/errors.proto*\xe0\x06\n" +
+	"\vErrorReason\x12\x17\n" +
+	"\x13GREETER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_NOT_FOUND\x10\x01\x12\x1d\n" +
+	"\x13SEAT_HOLD_NOT_OWNED\x10\x02\x1a\x04\xa8E\x93\x03\x12\x1b\n" +
+	"\x11SEATS_UNAVAILABLE\x10\x03\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12HOLD_LIMIT_REACHED\x10\x04\x1a\x04\xa8E\x99\x03\x12#\n" +
+	"\x19INVALID_STATUS_TRANSITION\x10\x05\x1a\x04\xa8E\x99\x03\x12 \n" +
+	"\x16IDEMPOTENCY_KEY_REUSED\x10\x06\x1a\x04\xa8E\x99\x03\x12)\n" +
+	"\x1fIDEMPOTENCY_REQUEST_IN_PROGRESS\x10\a\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14INVALID_LIST_REQUEST\x10\b\x1a\x04\xa8E\x90\x03\x12\x1d\n" +
+	"\x13ALREADY_ON_WAITLIST\x10\t\x1a\x04\xa8E\x99\x03\x12$\n" +
+	"\x1aWAITLIST_OFFER_UNAVAILABLE\x10\n" +
+	"\x1a\x04\xa8E\x99\x03\x12\"\n" +
+	"\x18WAITLIST_ENTRY_NOT_OWNED\x10\v\x1a\x04\xa8E\x93\x03\x12\"\n" +
+	"\x18TRANSFER_ALREADY_PENDING\x10\f\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14TRANSFER_UNAVAILABLE\x10\r\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14TRANSFER_NOT_ALLOWED\x10\x0e\x1a\x04\xa8E\x93\x03\x12\x1d\n" +
+	"\x13RECIPIENT_NOT_FOUND\x10\x0f\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11BOOKING_NOT_OWNED\x10\x10\x1a\x04\xa8E\x93\x03\x12\x1a\n" +
+	"\x10TICKET_NOT_FOUND\x10\x11\x1a\x04\xa8E\x94\x03\x12\x1d\n" +
+	"\x13TICKET_ALREADY_USED\x10\x12\x1a\x04\xa8E\x99\x03\x12\x1a\n" +
+	"\x10TICKET_NOT_VALID\x10\x13\x1a\x04\xa8E\x99\x03\x12\x19\n" +
+	"\x0fCHECK_IN_CLOSED\x10\x14\x1a\x04\xa8E\x99\x03\x12\x1e\n" +
+	"\x14PROMO_CODE_NOT_FOUND\x10\x15\x1a\x04\xa8E\x94\x03\x12#\n" +
+	"\x19PROMO_CODE_NOT_APPLICABLE\x10\x16\x1a\x04\xa8E\x99\x03\x12#\n" +
+	"\x19PROMO_CODE_ALREADY_EXISTS\x10\x17\x1a\x04\xa8E\x99\x03\x12\x1d\n" +
+	"\x13SEAT_LIMIT_EXCEEDED\x10\x18\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12ADMISSION_REQUIRED\x10\x19\x1a\x04\xa8E\x93\x03\x12\x16\n" +
+	"\fNOT_IN_QUEUE\x10\x1a\x1a\x04\xa8E\x94\x03BU\n" +
+	"\x11bookingservice.v1P\x01Z'bookingservice/api/bookingservice/v1;v1\xa2\x02\x14APIBookingservicedV1b\x06proto3"
+
+var (
+	file_bookingservice_v1_error_reason_proto_rawDescOnce sync.Once
+	file_bookingservice_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_bookingservice_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_bookingservice_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_bookingservice_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_bookingservice_v1_error_reason_proto_rawDesc), len(file_bookingservice_v1_error_reason_proto_rawDesc)))
+	})
+	return file_bookingservice_v1_error_reason_proto_rawDescData
+}
+
+var file_bookingservice_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bookingservice_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: bookingservice.v1.ErrorReason
+}
+var file_bookingservice_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_bookingservice_v1_error_reason_proto_init() }
+func file_bookingservice_v1_error_reason_proto_init() {
+	if File_bookingservice_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bookingservice_v1_error_reason_proto_rawDesc), len(file_bookingservice_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bookingservice_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_bookingservice_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_bookingservice_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_bookingservice_v1_error_reason_proto = out.File
+	file_bookingservice_v1_error_reason_proto_goTypes = nil
+	file_bookingservice_v1_error_reason_proto_depIdxs = nil
+}
@@ -5,9 +5,11 @@ import (
 	"os"
 
 	"bookingservice/internal/conf"
+	"bookingservice/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
 	"github.com/go-kratos/kratos/v2/config/file"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/tracing"
@@ -33,7 +35,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, sw *server.BookingSweeper, ob *server.OutboxRelay, sr *server.SagaRecovery, wl *server.WaitlistWorker, wr *server.WaitingRoomWorker, ir *server.InventoryReconciler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +45,12 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			sw,
+			ob,
+			sr,
+			wl,
+			wr,
+			ir,
 		),
 	)
 }
@@ -60,6 +68,7 @@ func main() {
 	)
 	c := config.New(
 		config.WithSource(
+			env.NewSource("BOOKING_"),
 			file.NewSource(flagconf),
 		),
 	)
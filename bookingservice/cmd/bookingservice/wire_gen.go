@@ -33,26 +33,133 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		cleanup()
 		return nil, nil, err
 	}
-	bookingRepo := data.NewBookingRepo(db, client)
+	seatLocker, err := data.NewSeatLocker(confData, client)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	seatFeed := data.NewSeatFeed(confData, client, logger)
+	bookingRepo, err := data.NewBookingRepo(db, seatLocker, seatFeed, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	transaction := data.NewTransaction(db)
+	outboxRepo, err := data.NewOutboxRepo(db)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	statusHistoryRepo := data.NewStatusHistoryRepo(db)
+	sagaRepo := data.NewSagaRepo(db)
+	idempotencyRepo := data.NewIdempotencyRepo(db)
+	waitlistRepo := data.NewWaitlistRepo(db)
+	seatCancellationRepo, err := data.NewSeatCancellationRepo(db, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	transferRepo := data.NewTransferRepo(db)
+	seatChangeRepo, err := data.NewSeatChangeRepo(db, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	ticketRepo := data.NewTicketRepo(db)
+	promoRepo, err := data.NewPromoRepo(db)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	eventServiceClient, cleanup3, err := data.ProvideEventClient()
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	bookingUsecase := biz.NewBookingUsecase(bookingRepo, eventServiceClient, logger)
-	notificationServiceClient, cleanup4, err := data.ProvideNotificationClient()
+	userServiceClient, cleanup4, err := data.ProvideUserClient()
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	paymentServiceClient, cleanup5, err := data.ProvidePaymentClient()
 	if err != nil {
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	holdPolicy := biz.ProvideHoldPolicy(confData)
+	waitlistPolicy := biz.ProvideWaitlistPolicy(confData)
+	seatLimitPolicy := biz.ProvideSeatLimitPolicy(confData)
+	ticketSigner, err := biz.ProvideTicketSigner(confData)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	admissionSigner, err := biz.ProvideAdmissionSigner(confData)
+	if err != nil {
+		cleanup5()
+		cleanup4()
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	bookingUsecase := biz.NewBookingUsecase(bookingRepo, transaction, outboxRepo, statusHistoryRepo, sagaRepo, idempotencyRepo, waitlistRepo, seatCancellationRepo, transferRepo, seatChangeRepo, ticketRepo, promoRepo, seatFeed, eventServiceClient, userServiceClient, paymentServiceClient, holdPolicy, waitlistPolicy, seatLimitPolicy, ticketSigner, admissionSigner, logger)
+	bookingService := service.NewBookingService(bookingUsecase, eventServiceClient, logger)
+	checkInPolicy := biz.ProvideCheckInPolicy(confData)
+	checkInUsecase := biz.NewCheckInUsecase(ticketRepo, bookingRepo, eventServiceClient, ticketSigner, checkInPolicy, logger)
+	checkInService := service.NewCheckInService(checkInUsecase)
+	promoUsecase := biz.NewPromoUsecase(promoRepo, logger)
+	promoService := service.NewPromoService(promoUsecase)
+	waitingRoomRepo := data.NewWaitingRoomRepo(confData, client)
+	leaseRepo := data.NewLeaseRepo(confData, client)
+	waitingRoomPolicy := biz.ProvideWaitingRoomPolicy(confData)
+	waitingRoomUsecase := biz.NewWaitingRoomUsecase(waitingRoomRepo, leaseRepo, eventServiceClient, admissionSigner, waitingRoomPolicy, logger)
+	waitingRoomService := service.NewWaitingRoomService(waitingRoomUsecase)
+	inventoryPolicy := biz.ProvideInventoryPolicy(confData)
+	inventoryUsecase := biz.NewInventoryUsecase(bookingRepo, sagaRepo, leaseRepo, eventServiceClient, inventoryPolicy, logger)
+	inventoryService := service.NewInventoryService(inventoryUsecase)
+	grpcServer := server.NewGRPCServer(confServer, bookingService, checkInService, promoService, waitingRoomService, inventoryService, logger)
+	httpServer := server.NewHTTPServer(confServer, bookingService, checkInService, promoService, waitingRoomService, inventoryService, logger)
+	expiryPolicy := biz.ProvideExpiryPolicy(confData)
+	expiryUsecase := biz.NewExpiryUsecase(bookingUsecase, bookingRepo, leaseRepo, expiryPolicy, logger)
+	bookingSweeper := server.NewBookingSweeper(expiryUsecase, logger)
+	notificationServiceClient, cleanup6, err := data.ProvideNotificationClient()
+	if err != nil {
+		cleanup5()
+		cleanup4()
 		cleanup3()
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	bookingService := service.NewBookingService(bookingUsecase, notificationServiceClient, eventServiceClient, logger)
-	grpcServer := server.NewGRPCServer(confServer, bookingService, logger)
-	httpServer := server.NewHTTPServer(confServer, bookingService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	outboxPolicy := biz.ProvideOutboxPolicy(confData)
+	outboxUsecase := biz.NewOutboxUsecase(outboxRepo, leaseRepo, seatCancellationRepo, seatChangeRepo, notificationServiceClient, paymentServiceClient, outboxPolicy, logger)
+	outboxRelay := server.NewOutboxRelay(outboxUsecase, logger)
+	sagaRecovery := server.NewSagaRecovery(bookingUsecase, logger)
+	waitlistUsecase := biz.NewWaitlistUsecase(bookingUsecase, waitlistRepo, leaseRepo, waitlistPolicy, logger)
+	waitlistWorker := server.NewWaitlistWorker(waitlistUsecase, logger)
+	waitingRoomWorker := server.NewWaitingRoomWorker(waitingRoomUsecase, logger)
+	inventoryReconciler := server.NewInventoryReconciler(inventoryUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, bookingSweeper, outboxRelay, sagaRecovery, waitlistWorker, waitingRoomWorker, inventoryReconciler)
 	return app, func() {
+		cleanup6()
+		cleanup5()
 		cleanup4()
 		cleanup3()
 		cleanup2()
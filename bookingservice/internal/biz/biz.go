@@ -3,4 +3,4 @@ package biz
 import "github.com/google/wire"
 
 // ProviderSet is biz providers.
-var ProviderSet = wire.NewSet(NewBookingUsecase)
+var ProviderSet = wire.NewSet(NewBookingUsecase, ProvideHoldPolicy, NewExpiryUsecase, ProvideExpiryPolicy, NewOutboxUsecase, ProvideOutboxPolicy, NewWaitlistUsecase, ProvideWaitlistPolicy, ProvideTicketSigner, NewCheckInUsecase, ProvideCheckInPolicy, NewPromoUsecase, ProvideSeatLimitPolicy, NewWaitingRoomUsecase, ProvideWaitingRoomPolicy, ProvideAdmissionSigner, NewInventoryUsecase, ProvideInventoryPolicy)
@@ -3,149 +3,373 @@ package biz
 import (
 	"context"
 	"fmt"
+	"time"
 
 	bookingv1 "bookingservice/api/bookingservice/v1"
 	eventv1 "eventservice/api/eventservice/v1"
+	moneyv1 "eventservice/api/money/v1"
+	paymentv1 "paymentservice/api/paymentservice/v1"
+	userv1 "userservice/api/userservice/v1"
 
 	"github.com/go-kratos/kratos/v2/log"
-	
+	"google.golang.org/protobuf/proto"
 )
 
 type BookingRepo interface {
-	Create(ctx context.Context, booking *bookingv1.Booking) (*bookingv1.Booking, error)
+	// Create saves booking, whose seats are held under holdToken.
+	Create(ctx context.Context, booking *bookingv1.Booking, holdToken string) (*bookingv1.Booking, error)
 	Get(ctx context.Context, id uint64) (*bookingv1.Booking, error)
-	List(ctx context.Context) ([]*bookingv1.Booking, error)
+	// GetHoldToken returns the token the booking's seats are held under
+	// while it is PENDING, or "" for bookings made before it was recorded.
+	GetHoldToken(ctx context.Context, id uint64) (string, error)
+	// List returns up to filter.Limit bookings matching filter, in filter.Sort
+	// order, after filter.After.
+	List(ctx context.Context, filter BookingFilter) (*BookingPage, error)
 	Update(ctx context.Context, booking *bookingv1.Booking) (*bookingv1.Booking, error)
-	Cancel(ctx context.Context, id uint64) (*bookingv1.Booking, error)
-	LockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) (bool, error)
-	UnlockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) error
-	GetLockedSeats(ctx context.Context, eventID uint64) ([]string, error)
-	ListByEventAndStatus(ctx context.Context, eventID uint64, status string) ([]*bookingv1.Booking, error)
+	// UpdateStatus sets the booking's status to `to` only if it is still
+	// `from`, and reports whether it did.
+	UpdateStatus(ctx context.Context, id uint64, from, to bookingv1.BookingStatus) (bool, error)
+	// HoldSeats locks all seatIDs for owner, or none of them. Seats the owner
+	// already holds are taken over, keeping their start time and extension
+	// count. The result carries the hold token (owner.Token, or a new one if
+	// that is empty), or the seats held by someone else.
+	HoldSeats(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold, policy HoldPolicy) (*HoldResult, error)
+	// ExtendSeats pushes back the expiry of a hold on seatIDs by policy.TTL.
+	// Every seat must be held under owner.Token. It returns ErrHoldLimitReached
+	// once the hold has used up its extensions or its maximum length.
+	ExtendSeats(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold, policy HoldPolicy) (*HoldResult, error)
+	// ReleaseSeats unlocks seatIDs only if every held one is held under
+	// owner.Token, and returns the seats that are not.
+	ReleaseSeats(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold) ([]string, error)
+	GetLockedSeats(ctx context.Context, eventID uint64) ([]*LockedSeat, error)
+	// ListBookedSeats returns the seats held by the event's CONFIRMED bookings.
+	ListBookedSeats(ctx context.Context, eventID uint64) ([]string, error)
+	// ListUserSeats returns the seats on userID's PENDING and CONFIRMED
+	// bookings of the event.
+	ListUserSeats(ctx context.Context, eventID, userID uint64) ([]string, error)
+	// RemoveSeats drops seatIDs from a booking that is still in status and
+	// lowers its total by amount, in minor units. It reports false, changing
+	// nothing, if the booking moved on, a seat is no longer on it, or no seat
+	// would be left.
+	RemoveSeats(ctx context.Context, id uint64, status bookingv1.BookingStatus, seatIDs []string, amount int64) (bool, error)
+	// SwapSeats replaces seats `remove` of a booking that is still in status
+	// with seats `add` and raises its total by delta, in minor units. It
+	// reports false, changing nothing, if the booking moved on or a seat to
+	// remove is no longer on it, and fails with SEATS_UNAVAILABLE if a
+	// CONFIRMED booking already has one of the new seats.
+	SwapSeats(ctx context.Context, id uint64, status bookingv1.BookingStatus, remove, add []string, delta int64) (bool, error)
+	// ChangeOwner moves a CONFIRMED booking of fromUserID to toUserID with a
+	// new ticket code, and reports whether it did.
+	ChangeOwner(ctx context.Context, id, fromUserID, toUserID uint64, ticketCode string) (bool, error)
+	// ListPendingBefore returns up to limit PENDING bookings created before
+	// the cutoff, oldest first.
+	ListPendingBefore(ctx context.Context, before time.Time, limit int) ([]*bookingv1.Booking, error)
 }
 
 type BookingUsecase struct {
-	repo        BookingRepo
-	eventClient eventv1.EventServiceClient
-	log         *log.Helper
+	repo           BookingRepo
+	tx             Transaction
+	outbox         OutboxRepo
+	history        StatusHistoryRepo
+	sagas          SagaRepo
+	idempotency    IdempotencyRepo
+	waitlist       WaitlistRepo
+	cancellations  SeatCancellationRepo
+	transfers      TransferRepo
+	seatChanges    SeatChangeRepo
+	tickets        TicketRepo
+	promos         PromoRepo
+	seatFeed       SeatFeed
+	eventClient    eventv1.EventServiceClient
+	userClient     userv1.UserServiceClient
+	paymentClient  paymentv1.PaymentServiceClient
+	holdPolicy     *HoldPolicy
+	waitlistPolicy *WaitlistPolicy
+	seatLimits     *SeatLimitPolicy
+	signer         *TicketSigner
+	admissions     *AdmissionSigner
+	log            *log.Helper
 }
 
-func NewBookingUsecase(repo BookingRepo, eventClient eventv1.EventServiceClient, logger log.Logger) *BookingUsecase {
+func NewBookingUsecase(repo BookingRepo, tx Transaction, outbox OutboxRepo, history StatusHistoryRepo, sagas SagaRepo, idempotency IdempotencyRepo, waitlist WaitlistRepo, cancellations SeatCancellationRepo, transfers TransferRepo, seatChanges SeatChangeRepo, tickets TicketRepo, promos PromoRepo, seatFeed SeatFeed, eventClient eventv1.EventServiceClient, userClient userv1.UserServiceClient, paymentClient paymentv1.PaymentServiceClient, holdPolicy *HoldPolicy, waitlistPolicy *WaitlistPolicy, seatLimits *SeatLimitPolicy, signer *TicketSigner, admissions *AdmissionSigner, logger log.Logger) *BookingUsecase {
 	return &BookingUsecase{
-		repo:        repo,
-		eventClient: eventClient,
-		log:         log.NewHelper(logger),
+		repo:           repo,
+		tx:             tx,
+		outbox:         outbox,
+		history:        history,
+		sagas:          sagas,
+		idempotency:    idempotency,
+		waitlist:       waitlist,
+		cancellations:  cancellations,
+		transfers:      transfers,
+		seatChanges:    seatChanges,
+		tickets:        tickets,
+		promos:         promos,
+		seatFeed:       seatFeed,
+		eventClient:    eventClient,
+		userClient:     userClient,
+		paymentClient:  paymentClient,
+		holdPolicy:     holdPolicy,
+		waitlistPolicy: waitlistPolicy,
+		seatLimits:     seatLimits,
+		signer:         signer,
+		admissions:     admissions,
+		log:            log.NewHelper(logger),
 	}
 }
 
 // Lock / Unlock
-func (uc *BookingUsecase) LockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) (bool, error) {
-	return uc.repo.LockSeat(ctx, eventID, seatID, userID)
+
+// HoldSeats holds seatIDs for owner. An event with a waiting room needs
+// admissionToken from its queue.
+func (uc *BookingUsecase) HoldSeats(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold, admissionToken string) (*HoldResult, error) {
+	if len(seatIDs) == 0 {
+		return nil, fmt.Errorf("no seats requested")
+	}
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: eventID})
+	if err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	if err := uc.checkAdmission(evResp.ShowEvent, owner.UserID, admissionToken); err != nil {
+		return nil, err
+	}
+	return uc.holdSeats(ctx, evResp.ShowEvent, seatIDs, owner, nil)
 }
 
-func (uc *BookingUsecase) UnlockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) error {
-	return uc.repo.UnlockSeat(ctx, eventID, seatID, userID)
+func (uc *BookingUsecase) ExtendSeatHold(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold) (*HoldResult, error) {
+	if len(seatIDs) == 0 {
+		return nil, fmt.Errorf("no seats requested")
+	}
+	if owner.Token == "" {
+		return nil, bookingv1.ErrorSeatHoldNotOwned("a hold token is required to extend a hold")
+	}
+	res, err := uc.repo.ExtendSeats(ctx, eventID, seatIDs, owner, *uc.holdPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Conflicts) > 0 {
+		return nil, bookingv1.ErrorSeatHoldNotOwned("seats %v are not held under this hold token", res.Conflicts)
+	}
+	uc.log.Infof("Seat hold extended: event_id=%d, user_id=%d, extensions=%d, expires_at=%s",
+		eventID, owner.UserID, res.Extensions, res.ExpiresAt.Format(time.RFC3339))
+	return res, nil
+}
+
+// ExtensionsLeft reports how many more times hold may be extended.
+func (uc *BookingUsecase) ExtensionsLeft(hold *HoldResult) int32 {
+	if left := uc.holdPolicy.MaxExtensions - hold.Extensions; left > 0 {
+		return left
+	}
+	return 0
+}
+
+func (uc *BookingUsecase) UnlockSeats(ctx context.Context, eventID uint64, seatIDs []string, owner SeatHold) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+	if owner.Token == "" {
+		return bookingv1.ErrorSeatHoldNotOwned("a hold token is required to release a hold")
+	}
+	notOwned, err := uc.repo.ReleaseSeats(ctx, eventID, seatIDs, owner)
+	if err != nil {
+		return err
+	}
+	if len(notOwned) > 0 {
+		return bookingv1.ErrorSeatHoldNotOwned("seats %v are not held by user %d", notOwned, owner.UserID)
+	}
+	return nil
 }
 
 // Get locked / booked seats
-func (uc *BookingUsecase) GetLockedSeats(ctx context.Context, eventID uint64) ([]string, error) {
+func (uc *BookingUsecase) GetLockedSeats(ctx context.Context, eventID uint64) ([]*LockedSeat, error) {
 	uc.log.Infof("Fetching locked seats for event_id=%d", eventID)
 	return uc.repo.GetLockedSeats(ctx, eventID)
 }
 
 func (uc *BookingUsecase) GetBookedSeats(ctx context.Context, eventID uint64) ([]string, error) {
 	uc.log.Infof("Fetching booked seats for event_id=%d", eventID)
-	bookings, err := uc.repo.ListByEventAndStatus(ctx, eventID, "CONFIRMED")
-	if err != nil {
-		return nil, err
-	}
-	var seatIDs []string
-	for _, b := range bookings {
-		seatIDs = append(seatIDs, b.SeatIds...)
-	}
-	return seatIDs, nil
+	return uc.repo.ListBookedSeats(ctx, eventID)
 }
 
 // CRUD
 func (uc *BookingUsecase) Create(ctx context.Context, req *bookingv1.CreateBookingRequest) (*bookingv1.Booking, error) {
+    return uc.create(ctx, req, true, nil)
+}
+
+// create books req.SeatIds. With admit, a booking of an event with a
+// waiting room needs req.AdmissionToken. inTx, when set, runs in the
+// transaction that saves the booking.
+func (uc *BookingUsecase) create(ctx context.Context, req *bookingv1.CreateBookingRequest, admit bool, inTx func(ctx context.Context, booking *bookingv1.Booking) error) (*bookingv1.Booking, error) {
     // 1️⃣ Validate user
     valid, err := uc.eventClient.ValidateUser(ctx, &eventv1.ValidateUserRequest{Id: req.UserId})
     if err != nil || !valid.Found {
         return nil, fmt.Errorf("user not found")
     }
 
-    // 2️⃣ Get event
+    // 2️⃣ Get event, check the user was let in through its waiting room, and
+    // get the promo code if one was given
     evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: req.EventId})
     if err != nil {
         return nil, fmt.Errorf("event not found")
     }
     ev := evResp.ShowEvent
+    if admit {
+        if err := uc.checkAdmission(ev, req.UserId, req.AdmissionToken); err != nil {
+            return nil, err
+        }
+    }
+    promo, err := uc.lookupPromo(ctx, req.PromoCode, req.EventId, ev.GetPrice().GetCurrency())
+    if err != nil {
+        return nil, err
+    }
 
-    // 3️⃣ Check available seats
-    if int32(len(req.SeatIds)) > ev.AvailableSeats {
+    // 3️⃣ Check available seats, less those offered to waitlisted users
+    reserved, err := uc.waitlist.ReservedSeats(ctx, req.EventId, req.UserId)
+    if err != nil {
+        return nil, err
+    }
+    if int32(len(req.SeatIds)) > ev.AvailableSeats-reserved {
         return nil, fmt.Errorf("not enough seats available")
     }
 
-    // 4️⃣ Lock seats
-    lockedSeats := []string{}
-    for _, seatID := range req.SeatIds {
-        locked, _ := uc.repo.LockSeat(ctx, req.EventId, seatID, req.UserId)
-        if !locked {
-            for _, s := range lockedSeats {
-                _ = uc.repo.UnlockSeat(ctx, req.EventId, s, req.UserId)
-            }
-            return nil, fmt.Errorf("seat already taken")
+    // 4️⃣ Lock seats (all or nothing), or take over the caller's own hold
+    owner := SeatHold{UserID: req.UserId, Token: req.HoldToken}
+    hold, err := uc.holdSeats(ctx, ev, req.SeatIds, owner, nil)
+    if err != nil {
+        return nil, err
+    }
+    if len(hold.Conflicts) > 0 {
+        if req.HoldToken != "" {
+            return nil, bookingv1.ErrorSeatHoldNotOwned("seats %v are not held under this hold token", hold.Conflicts)
+        }
+        return nil, bookingv1.ErrorSeatsUnavailable("seats already taken: %v", hold.Conflicts)
+    }
+    // A hold taken over from LockSeat stays with the caller to retry with
+    releaseNew := func() {
+        if req.HoldToken != "" {
+            return
+        }
+        if _, err := uc.repo.ReleaseSeats(ctx, req.EventId, req.SeatIds, SeatHold{UserID: req.UserId, Token: hold.Token}); err != nil {
+            uc.log.Errorf("Failed to release holds of user %d on event %d: %v", req.UserId, req.EventId, err)
         }
-        lockedSeats = append(lockedSeats, seatID)
     }
 
-    // 5️⃣ Calculate total cost
-    totalCost := float32(len(req.SeatIds)) * ev.PricePerSeat
+    // 5️⃣ Calculate total cost, less any promo discount
+    totalCost := &moneyv1.Money{
+        AmountMinor: int64(len(req.SeatIds)) * ev.GetPrice().GetAmountMinor(),
+        Currency:    ev.GetPrice().GetCurrency(),
+    }
 
     // 6️⃣ Create booking
+    ticketCode, err := newTicketCode()
+    if err != nil {
+        releaseNew()
+        return nil, err
+    }
     booking := &bookingv1.Booking{
-        UserId:    req.UserId,
-        EventId:   req.EventId,
-        SeatIds:   req.SeatIds,
-        Status:    "PENDING",
-        TotalCost: totalCost,
+        UserId:     req.UserId,
+        EventId:    req.EventId,
+        SeatIds:    req.SeatIds,
+        Status:     bookingv1.BookingStatus_PENDING,
+        TotalCost:  totalCost,
+        TicketCode: ticketCode,
     }
+    applyPromo(booking, promo)
 
-    return uc.repo.Create(ctx, booking)
+    // 7️⃣ Save it together with its outbox message and promo code use
+    var created *bookingv1.Booking
+    err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+        var err error
+        if created, err = uc.repo.Create(ctx, booking, hold.Token); err != nil {
+            return err
+        }
+        if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicBookingCreated, BookingID: created.Id}); err != nil {
+            return err
+        }
+        if err := uc.recordStatus(ctx, created.Id, bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED, created.Status, ByUser(req.UserId, "booked")); err != nil {
+            return err
+        }
+        if promo != nil {
+            if err := uc.promos.Redeem(ctx, promo, created.Id, req.UserId); err != nil {
+                return err
+            }
+        }
+        if inTx != nil {
+            return inTx(ctx, created)
+        }
+        return nil
+    })
+    if err != nil {
+        releaseNew()
+        return nil, err
+    }
+    return created, nil
 }
 
+// CreateIdempotent creates a booking once per req.IdempotencyKey. A retry
+// with the same key and body returns the first booking and reports it as a
+// replay; without a key it behaves like Create.
+func (uc *BookingUsecase) CreateIdempotent(ctx context.Context, req *bookingv1.CreateBookingRequest) (*bookingv1.Booking, bool, error) {
+	if req.IdempotencyKey == "" {
+		booking, err := uc.Create(ctx, req)
+		return booking, false, err
+	}
 
+	body := proto.Clone(req).(*bookingv1.CreateBookingRequest)
+	body.IdempotencyKey = ""
+	fp, err := fingerprint(body)
+	if err != nil {
+		return nil, false, err
+	}
 
-func (uc *BookingUsecase) ConfirmBooking(ctx context.Context, bookingID uint64) (*bookingv1.Booking, error) {
-	booking, err := uc.repo.Get(ctx, bookingID)
+	scope := fmt.Sprintf("CreateBooking/%d", req.UserId)
+	res, replayed, err := runIdempotent(ctx, uc.idempotency, scope, req.IdempotencyKey, fp, func() ([]byte, error) {
+		booking, err := uc.Create(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(booking)
+	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	booking := &bookingv1.Booking{}
+	if err := proto.Unmarshal(res, booking); err != nil {
+		return nil, false, err
 	}
-	booking.Status = "CONFIRMED"
-	updated, err := uc.repo.Update(ctx, booking)
+	if replayed {
+		uc.log.Infof("Replayed CreateBooking for idempotency key %q: booking_id=%d", req.IdempotencyKey, booking.Id)
+	}
+	return booking, replayed, nil
+}
+
+
+
+// ConfirmBooking confirms booking bookingID on behalf of its owner userID.
+func (uc *BookingUsecase) ConfirmBooking(ctx context.Context, bookingID, userID uint64) (*bookingv1.Booking, error) {
+	booking, err := uc.repo.Get(ctx, bookingID)
 	if err != nil {
 		return nil, err
 	}
-	if len(booking.SeatIds) > 0 {
-		_, _ = uc.eventClient.DecrementSeats(ctx, &eventv1.DecrementSeatsRequest{
-			EventId: booking.EventId,
-			SeatIds: booking.SeatIds,
-		})
+	if booking.UserId != userID {
+		return nil, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", bookingID, userID)
 	}
-	return updated, nil
+	return uc.UpdateStatus(ctx, bookingID, bookingv1.BookingStatus_CONFIRMED, ByUser(userID, "confirmed"))
 }
 
-func (uc *BookingUsecase) Cancel(ctx context.Context, bookingID uint64) (*bookingv1.Booking, error) {
-	return uc.repo.Cancel(ctx, bookingID)
+// Cancel cancels booking bookingID on behalf of its owner userID.
+func (uc *BookingUsecase) Cancel(ctx context.Context, bookingID, userID uint64, reason string) (*bookingv1.Booking, error) {
+	booking, err := uc.repo.Get(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != userID {
+		return nil, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", bookingID, userID)
+	}
+	return uc.UpdateStatus(ctx, bookingID, bookingv1.BookingStatus_CANCELLED, ByUser(userID, reason))
 }
 
 func (uc *BookingUsecase) Get(ctx context.Context, id uint64) (*bookingv1.Booking, error) {
 	return uc.repo.Get(ctx, id)
 }
-
-func (uc *BookingUsecase) Update(ctx context.Context, booking *bookingv1.Booking) (*bookingv1.Booking, error) {
-	return uc.repo.Update(ctx, booking)
-}
-
-func (uc *BookingUsecase) List(ctx context.Context) ([]*bookingv1.Booking, error) {
-	return uc.repo.List(ctx)
-}
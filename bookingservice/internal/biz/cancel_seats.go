@@ -0,0 +1,161 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	eventv1 "eventservice/api/eventservice/v1"
+)
+
+// TopicSeatsCancelled is the outbox topic for seats dropped from a
+// CONFIRMED booking; delivering it requests the refund from paymentservice.
+const TopicSeatsCancelled = "booking.seats_cancelled"
+
+// SeatCancellation records seats dropped from a booking and the refund owed
+// for them.
+type SeatCancellation struct {
+	ID           uint64
+	BookingID    uint64
+	EventID      uint64
+	SeatIDs      []string
+	RefundAmount int64 // in minor units of Currency
+	Currency     string
+	CreatedAt    time.Time
+}
+
+// SeatCancellationRepo stores seat cancellations.
+type SeatCancellationRepo interface {
+	Create(ctx context.Context, c *SeatCancellation) (*SeatCancellation, error)
+	Get(ctx context.Context, id uint64) (*SeatCancellation, error)
+}
+
+// CancelSeats drops seatIDs from a PENDING or CONFIRMED booking of userID
+// and lowers its total by their share of it. A PENDING booking's holds on
+// the seats are released. A CONFIRMED booking's tickets for the seats are
+// voided, the seats go back to the event and the refund is queued in the
+// outbox, all in the transaction that removes the seats; the seats are then
+// offered to the event's waitlist. It returns the updated booking and the
+// refund amount in minor units of the booking's currency.
+func (uc *BookingUsecase) CancelSeats(ctx context.Context, id, userID uint64, seatIDs []string) (*bookingv1.Booking, int64, error) {
+	booking, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if booking.UserId != userID {
+		return nil, 0, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", id, userID)
+	}
+	status := booking.Status
+	if status != bookingv1.BookingStatus_PENDING && status != bookingv1.BookingStatus_CONFIRMED {
+		return nil, 0, bookingv1.ErrorInvalidStatusTransition("seats of a %s booking cannot be cancelled", status)
+	}
+	if err := checkSeatsToCancel(booking.SeatIds, seatIDs); err != nil {
+		return nil, 0, err
+	}
+
+	// Seats were all priced the same, so each one's share is an even split.
+	// Only a paid booking gets money back.
+	share := seatShare(booking.GetTotalCost().GetAmountMinor(), len(seatIDs), len(booking.SeatIds))
+	confirmed := status == bookingv1.BookingStatus_CONFIRMED
+	refund := int64(0)
+	if confirmed {
+		refund = share
+	}
+
+	operationID := ""
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.repo.RemoveSeats(ctx, id, status, seatIDs, share)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorInvalidStatusTransition("booking %d changed while its seats were being cancelled", id)
+		}
+		c, err := uc.cancellations.Create(ctx, &SeatCancellation{
+			BookingID:    id,
+			EventID:      booking.EventId,
+			SeatIDs:      seatIDs,
+			RefundAmount: refund,
+			Currency:     booking.GetTotalCost().GetCurrency(),
+		})
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+		if err := uc.tickets.Void(ctx, id, seatIDs); err != nil {
+			return err
+		}
+		if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicSeatsCancelled, BookingID: id, SeatCancellationID: c.ID}); err != nil {
+			return err
+		}
+		op := fmt.Sprintf("booking-%d-cancel-seats-%d", id, c.ID)
+		if _, err := uc.eventClient.IncrementSeats(ctx, &eventv1.IncrementSeatsRequest{
+			EventId:     booking.EventId,
+			SeatIds:     seatIDs,
+			OperationId: op,
+		}); err != nil {
+			return err
+		}
+		operationID = op
+		return nil
+	})
+	if err != nil {
+		if operationID != "" {
+			if _, rbErr := uc.eventClient.RevertSeatAdjustment(ctx, &eventv1.RevertSeatAdjustmentRequest{OperationId: operationID}); rbErr != nil {
+				uc.log.Errorf("Failed to revert inventory for booking %d: %v", id, rbErr)
+			}
+		}
+		return nil, 0, err
+	}
+
+	if confirmed {
+		if _, err := uc.offerWaitlist(ctx, booking.EventId); err != nil {
+			uc.log.Errorf("Failed to offer seats of event %d: %v", booking.EventId, err)
+		}
+	} else {
+		uc.releaseHolds(ctx, &bookingv1.Booking{Id: id, UserId: booking.UserId, EventId: booking.EventId, SeatIds: seatIDs})
+	}
+
+	uc.log.Infof("Booking %d: cancelled seats %v, refund %d %s", id, seatIDs, refund, booking.GetTotalCost().GetCurrency())
+	updated, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return updated, refund, nil
+}
+
+// checkSeatsToCancel makes sure drop names distinct seats of the booking
+// and leaves at least one; dropping them all is CancelBooking's job.
+func checkSeatsToCancel(booked, drop []string) error {
+	if len(drop) == 0 {
+		return fmt.Errorf("no seats to cancel")
+	}
+	onBooking := make(map[string]bool, len(booked))
+	for _, s := range booked {
+		onBooking[s] = true
+	}
+	seen := make(map[string]bool, len(drop))
+	for _, s := range drop {
+		if !onBooking[s] {
+			return fmt.Errorf("seat %s is not part of the booking", s)
+		}
+		if seen[s] {
+			return fmt.Errorf("seat %s is listed twice", s)
+		}
+		seen[s] = true
+	}
+	if len(drop) == len(booked) {
+		return fmt.Errorf("cannot cancel every seat; cancel the booking instead")
+	}
+	return nil
+}
+
+// seatShare is n of count seats' share of total, in minor units. It rounds
+// down, so the seats that stay on a booking keep any odd minor unit and the
+// shares never add up to more than the total.
+func seatShare(total int64, n, count int) int64 {
+	return total * int64(n) / int64(count)
+}
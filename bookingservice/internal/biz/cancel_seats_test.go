@@ -0,0 +1,66 @@
+package biz
+
+import "testing"
+
+func TestSeatShare(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int64
+		n, count int
+		want     int64
+	}{
+		{name: "even split", total: 3000, n: 1, count: 3, want: 1000},
+		{name: "odd unit stays on the booking", total: 1000, n: 1, count: 3, want: 333},
+		{name: "two of three", total: 1000, n: 2, count: 3, want: 666},
+		{name: "all seats", total: 1001, n: 3, count: 3, want: 1001},
+		{name: "free booking", total: 0, n: 1, count: 2, want: 0},
+		{name: "less than a unit each", total: 2, n: 1, count: 3, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seatShare(tt.total, tt.n, tt.count); got != tt.want {
+				t.Errorf("seatShare(%d, %d, %d) = %d, want %d", tt.total, tt.n, tt.count, got, tt.want)
+			}
+		})
+	}
+}
+
+// Cancelling seats one at a time never refunds more than the booking cost.
+func TestSeatShareNeverExceedsTotal(t *testing.T) {
+	for _, total := range []int64{1, 99, 100, 1001, 99999} {
+		for count := 1; count <= 7; count++ {
+			left, refunded := total, int64(0)
+			for seats := count; seats > 1; seats-- {
+				share := seatShare(left, 1, seats)
+				left -= share
+				refunded += share
+			}
+			if refunded > total || left < 0 {
+				t.Errorf("total %d over %d seats: refunded %d, left %d", total, count, refunded, left)
+			}
+		}
+	}
+}
+
+func TestCheckSeatsToCancel(t *testing.T) {
+	booked := []string{"A1", "A2", "A3"}
+	tests := []struct {
+		name    string
+		drop    []string
+		wantErr bool
+	}{
+		{name: "one seat", drop: []string{"A2"}},
+		{name: "all but one", drop: []string{"A1", "A3"}},
+		{name: "none", wantErr: true},
+		{name: "every seat", drop: []string{"A1", "A2", "A3"}, wantErr: true},
+		{name: "not on booking", drop: []string{"B1"}, wantErr: true},
+		{name: "listed twice", drop: []string{"A1", "A1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkSeatsToCancel(booked, tt.drop); (err != nil) != tt.wantErr {
+				t.Errorf("checkSeatsToCancel(%v) = %v, want error %v", tt.drop, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	eventv1 "eventservice/api/eventservice/v1"
+	moneyv1 "eventservice/api/money/v1"
+	paymentv1 "paymentservice/api/paymentservice/v1"
+)
+
+// TopicSeatsChanged is the outbox topic for a seat change that made a
+// CONFIRMED booking cheaper; delivering it requests the refund from
+// paymentservice.
+const TopicSeatsChanged = "booking.seats_changed"
+
+// SeatChange records a booking moving from some seats to others and the
+// price difference it caused. A positive difference was charged, a negative
+// one is refunded.
+type SeatChange struct {
+	ID              uint64
+	BookingID       uint64
+	EventID         uint64
+	OldSeatIDs      []string
+	NewSeatIDs      []string
+	PriceDifference int64 // in minor units of Currency
+	Currency        string
+	CreatedAt       time.Time
+}
+
+// SeatChangeRepo stores seat changes.
+type SeatChangeRepo interface {
+	Create(ctx context.Context, c *SeatChange) (*SeatChange, error)
+	Get(ctx context.Context, id uint64) (*SeatChange, error)
+}
+
+// ChangeSeats moves a PENDING or CONFIRMED booking of userID from oldSeatIDs
+// to newSeatIDs. The new seats are held first, so nothing changes unless
+// they can be had. For a CONFIRMED booking that gets dearer, the difference
+// is charged before the swap and refunded again if the swap fails. The swap,
+// the new total, the ticket changes, the event's inventory change and any
+// refund request are then applied in one transaction. It returns the
+// updated booking and the price difference in minor units of the booking's
+// currency.
+func (uc *BookingUsecase) ChangeSeats(ctx context.Context, id, userID uint64, oldSeatIDs, newSeatIDs []string, holdToken string) (*bookingv1.Booking, int64, error) {
+	booking, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if booking.UserId != userID {
+		return nil, 0, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", id, userID)
+	}
+	status := booking.Status
+	if status != bookingv1.BookingStatus_PENDING && status != bookingv1.BookingStatus_CONFIRMED {
+		return nil, 0, bookingv1.ErrorInvalidStatusTransition("seats of a %s booking cannot be changed", status)
+	}
+	if err := checkSeatChange(booking.SeatIds, oldSeatIDs, newSeatIDs); err != nil {
+		return nil, 0, err
+	}
+
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: booking.EventId})
+	if err != nil {
+		return nil, 0, fmt.Errorf("event not found")
+	}
+	ev := evResp.ShowEvent
+	currency := booking.GetTotalCost().GetCurrency()
+	if ev.GetPrice().GetCurrency() != currency {
+		return nil, 0, fmt.Errorf("event %d is now priced in %s, but booking %d was in %s", booking.EventId, ev.GetPrice().GetCurrency(), id, currency)
+	}
+	extra := len(newSeatIDs) - len(oldSeatIDs)
+	if extra > 0 {
+		reserved, err := uc.waitlist.ReservedSeats(ctx, booking.EventId, userID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if int32(extra) > ev.AvailableSeats-reserved {
+			return nil, 0, fmt.Errorf("not enough seats available")
+		}
+	}
+
+	// 1️⃣ Hold the new seats, or take over the caller's own hold on them;
+	// the old seats do not count towards the user's seat limit. A PENDING
+	// booking's new seats join the hold its other seats are under.
+	owner := SeatHold{UserID: userID, Token: holdToken}
+	if holdToken == "" && status == bookingv1.BookingStatus_PENDING {
+		if owner.Token, err = uc.repo.GetHoldToken(ctx, id); err != nil {
+			return nil, 0, err
+		}
+	}
+	hold, err := uc.holdSeats(ctx, ev, newSeatIDs, owner, oldSeatIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(hold.Conflicts) > 0 {
+		if holdToken != "" {
+			return nil, 0, bookingv1.ErrorSeatHoldNotOwned("seats %v are not held under this hold token", hold.Conflicts)
+		}
+		return nil, 0, bookingv1.ErrorSeatsUnavailable("seats already taken: %v", hold.Conflicts)
+	}
+	newHold := SeatHold{UserID: userID, Token: hold.Token}
+	releaseNew := func() {
+		if _, err := uc.repo.ReleaseSeats(ctx, booking.EventId, newSeatIDs, newHold); err != nil {
+			uc.log.Errorf("Failed to release holds for booking %d: %v", id, err)
+		}
+	}
+
+	// 2️⃣ Price the new seats at the event's current price; the old seats
+	// are worth their share of what the booking cost
+	oldShare := seatShare(booking.GetTotalCost().GetAmountMinor(), len(oldSeatIDs), len(booking.SeatIds))
+	diff := int64(len(newSeatIDs))*ev.GetPrice().GetAmountMinor() - oldShare
+	confirmed := status == bookingv1.BookingStatus_CONFIRMED
+
+	// 3️⃣ Charge a dearer CONFIRMED booking up front
+	chargeKey := fmt.Sprintf("seat-change-%d-%s", id, hold.Token)
+	charged := false
+	if confirmed && diff > 0 {
+		if _, err := uc.paymentClient.ChargeBooking(ctx, &paymentv1.ChargeBookingRequest{
+			BookingId:      id,
+			Amount:         &moneyv1.Money{AmountMinor: diff, Currency: currency},
+			Reason:         fmt.Sprintf("seats %s changed to %s", strings.Join(oldSeatIDs, ", "), strings.Join(newSeatIDs, ", ")),
+			IdempotencyKey: chargeKey,
+		}); err != nil {
+			releaseNew()
+			return nil, 0, fmt.Errorf("failed to charge the seat change: %w", err)
+		}
+		charged = true
+	}
+
+	// 4️⃣ Swap the seats, adjust inventory and queue any refund together
+	operationID := ""
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.repo.SwapSeats(ctx, id, status, oldSeatIDs, newSeatIDs, diff)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorInvalidStatusTransition("booking %d changed while its seats were being changed", id)
+		}
+		c, err := uc.seatChanges.Create(ctx, &SeatChange{
+			BookingID:       id,
+			EventID:         booking.EventId,
+			OldSeatIDs:      oldSeatIDs,
+			NewSeatIDs:      newSeatIDs,
+			PriceDifference: diff,
+			Currency:        currency,
+		})
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return nil
+		}
+		if err := uc.tickets.Void(ctx, id, oldSeatIDs); err != nil {
+			return err
+		}
+		if err := uc.issueTickets(ctx, id); err != nil {
+			return err
+		}
+		if diff < 0 {
+			if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicSeatsChanged, BookingID: id, SeatChangeID: c.ID}); err != nil {
+				return err
+			}
+		}
+		op := fmt.Sprintf("booking-%d-change-seats-%d", id, c.ID)
+		switch {
+		case extra > 0:
+			_, err = uc.eventClient.DecrementSeats(ctx, &eventv1.DecrementSeatsRequest{
+				EventId:     booking.EventId,
+				SeatIds:     newSeatIDs[len(oldSeatIDs):],
+				OperationId: op,
+			})
+		case extra < 0:
+			_, err = uc.eventClient.IncrementSeats(ctx, &eventv1.IncrementSeatsRequest{
+				EventId:     booking.EventId,
+				SeatIds:     oldSeatIDs[len(newSeatIDs):],
+				OperationId: op,
+			})
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		operationID = op
+		return nil
+	})
+	if err != nil {
+		if operationID != "" {
+			if _, rbErr := uc.eventClient.RevertSeatAdjustment(ctx, &eventv1.RevertSeatAdjustmentRequest{OperationId: operationID}); rbErr != nil {
+				uc.log.Errorf("Failed to revert inventory for booking %d: %v", id, rbErr)
+			}
+		}
+		if charged {
+			if _, rfErr := uc.paymentClient.RefundPayment(ctx, &paymentv1.RefundPaymentRequest{
+				BookingId:      id,
+				Amount:         &moneyv1.Money{AmountMinor: diff, Currency: currency},
+				Reason:         "seat change failed",
+				IdempotencyKey: chargeKey + "-void",
+			}); rfErr != nil {
+				uc.log.Errorf("Failed to refund the seat change charge of booking %d: %v", id, rfErr)
+			}
+		}
+		releaseNew()
+		return nil, 0, err
+	}
+
+	// 5️⃣ A CONFIRMED booking now owns the new seats outright and may have
+	// given seats back; a PENDING one keeps holding the new seats until it
+	// is confirmed and lets go of the old ones
+	if confirmed {
+		releaseNew()
+		if extra < 0 {
+			if _, err := uc.offerWaitlist(ctx, booking.EventId); err != nil {
+				uc.log.Errorf("Failed to offer seats of event %d: %v", booking.EventId, err)
+			}
+		}
+	} else {
+		uc.releaseHolds(ctx, &bookingv1.Booking{Id: id, UserId: userID, EventId: booking.EventId, SeatIds: oldSeatIDs})
+	}
+
+	uc.log.Infof("Booking %d: changed seats %v to %v, price difference %d %s", id, oldSeatIDs, newSeatIDs, diff, currency)
+	updated, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return updated, diff, nil
+}
+
+// checkSeatChange makes sure drop names distinct seats of the booking and
+// take names distinct seats that are not on it yet.
+func checkSeatChange(booked, drop, take []string) error {
+	if len(drop) == 0 || len(take) == 0 {
+		return fmt.Errorf("both the seats to give up and the seats to take are required")
+	}
+	onBooking := make(map[string]bool, len(booked))
+	for _, s := range booked {
+		onBooking[s] = true
+	}
+	seen := make(map[string]bool, len(drop)+len(take))
+	for _, s := range drop {
+		if !onBooking[s] {
+			return fmt.Errorf("seat %s is not part of the booking", s)
+		}
+		if seen[s] {
+			return fmt.Errorf("seat %s is listed twice", s)
+		}
+		seen[s] = true
+	}
+	for _, s := range take {
+		if onBooking[s] {
+			return fmt.Errorf("seat %s is already part of the booking", s)
+		}
+		if seen[s] {
+			return fmt.Errorf("seat %s is listed twice", s)
+		}
+		seen[s] = true
+	}
+	return nil
+}
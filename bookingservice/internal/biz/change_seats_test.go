@@ -0,0 +1,31 @@
+package biz
+
+import "testing"
+
+func TestCheckSeatChange(t *testing.T) {
+	booked := []string{"A1", "A2", "A3"}
+	tests := []struct {
+		name       string
+		drop, take []string
+		wantErr    bool
+	}{
+		{name: "one for one", drop: []string{"A1"}, take: []string{"B1"}},
+		{name: "fewer seats", drop: []string{"A1", "A2"}, take: []string{"B1"}},
+		{name: "more seats", drop: []string{"A1"}, take: []string{"B1", "B2"}},
+		{name: "every seat", drop: []string{"A1", "A2", "A3"}, take: []string{"B1"}},
+		{name: "nothing to give up", take: []string{"B1"}, wantErr: true},
+		{name: "nothing to take", drop: []string{"A1"}, wantErr: true},
+		{name: "drop not on booking", drop: []string{"C1"}, take: []string{"B1"}, wantErr: true},
+		{name: "take already on booking", drop: []string{"A1"}, take: []string{"A2"}, wantErr: true},
+		{name: "take a dropped seat back", drop: []string{"A1"}, take: []string{"A1"}, wantErr: true},
+		{name: "drop twice", drop: []string{"A1", "A1"}, take: []string{"B1"}, wantErr: true},
+		{name: "take twice", drop: []string{"A1"}, take: []string{"B1", "B1"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkSeatChange(booked, tt.drop, tt.take); (err != nil) != tt.wantErr {
+				t.Errorf("checkSeatChange(%v, %v) = %v, want error %v", tt.drop, tt.take, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+	eventv1 "eventservice/api/eventservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Attendance is the live check-in count of an event.
+type Attendance struct {
+	EventID   uint64
+	CheckedIn int64
+	Tickets   int64
+	ByGate    map[string]int64
+}
+
+// CheckInPolicy is the window around an event's start in which tickets are
+// admitted.
+type CheckInPolicy struct {
+	OpensBefore time.Duration
+	ClosesAfter time.Duration
+}
+
+// ProvideCheckInPolicy reads the check-in window from config, falling back
+// to doors opening 3 hours before the event and scanning stopping 12 hours
+// after it starts.
+func ProvideCheckInPolicy(c *conf.Data) *CheckInPolicy {
+	p := &CheckInPolicy{
+		OpensBefore: 3 * time.Hour,
+		ClosesAfter: 12 * time.Hour,
+	}
+	ci := c.GetCheckIn()
+	if ci == nil {
+		return p
+	}
+	if ci.OpensBefore != nil && ci.OpensBefore.AsDuration() > 0 {
+		p.OpensBefore = ci.OpensBefore.AsDuration()
+	}
+	if ci.ClosesAfter != nil && ci.ClosesAfter.AsDuration() > 0 {
+		p.ClosesAfter = ci.ClosesAfter.AsDuration()
+	}
+	return p
+}
+
+// CheckInUsecase admits tickets at the venue door.
+type CheckInUsecase struct {
+	tickets     TicketRepo
+	bookings    BookingRepo
+	eventClient eventv1.EventServiceClient
+	signer      *TicketSigner
+	policy      *CheckInPolicy
+	log         *log.Helper
+}
+
+func NewCheckInUsecase(tickets TicketRepo, bookings BookingRepo, eventClient eventv1.EventServiceClient, signer *TicketSigner, policy *CheckInPolicy, logger log.Logger) *CheckInUsecase {
+	return &CheckInUsecase{
+		tickets:     tickets,
+		bookings:    bookings,
+		eventClient: eventClient,
+		signer:      signer,
+		policy:      policy,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// CheckIn admits the ticket with code, which may also be a signed QR
+// payload, at gate. eventID, when set, must be the ticket's event. The
+// ticket is marked used with a conditional update, so of two scans racing
+// for it only one succeeds and the other gets TICKET_ALREADY_USED.
+func (uc *CheckInUsecase) CheckIn(ctx context.Context, code, gate string, eventID uint64) (*Ticket, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, fmt.Errorf("code is required")
+	}
+	if strings.Count(code, ".") == 2 {
+		claims, err := uc.signer.Verify(code)
+		if err != nil {
+			return nil, bookingv1.ErrorTicketNotFound("ticket payload is not valid")
+		}
+		code = claims.Code
+	}
+	ticket, err := uc.tickets.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if eventID != 0 && ticket.EventID != eventID {
+		return nil, bookingv1.ErrorTicketNotValid("ticket %d is for event %d, not %d", ticket.ID, ticket.EventID, eventID)
+	}
+	if err := uc.checkTicket(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ok, err := uc.tickets.CheckIn(ctx, ticket.ID, gate, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Someone else scanned it, or it was voided, since we read it
+		if ticket, err = uc.tickets.GetByCode(ctx, code); err != nil {
+			return nil, err
+		}
+		if err := uc.checkTicket(ctx, ticket); err != nil {
+			return nil, err
+		}
+		return nil, bookingv1.ErrorTicketNotValid("ticket %d could not be checked in", ticket.ID)
+	}
+	ticket.CheckedInAt, ticket.CheckedInGate = now, gate
+	uc.log.Infof("Ticket %d checked in: booking_id=%d, event_id=%d, seat=%s, gate=%q", ticket.ID, ticket.BookingID, ticket.EventID, ticket.SeatID, gate)
+	return ticket, nil
+}
+
+// checkTicket returns why ticket cannot be admitted now, if it cannot.
+func (uc *CheckInUsecase) checkTicket(ctx context.Context, ticket *Ticket) error {
+	if !ticket.CheckedInAt.IsZero() {
+		at := ticket.CheckedInAt.Format(time.RFC3339)
+		return bookingv1.ErrorTicketAlreadyUsed("ticket %d was checked in at %s at gate %q", ticket.ID, at, ticket.CheckedInGate).
+			WithMetadata(map[string]string{"checked_in_at": at, "gate": ticket.CheckedInGate})
+	}
+	if ticket.Status != bookingv1.TicketStatus_TICKET_VALID {
+		return bookingv1.ErrorTicketNotValid("ticket %d is %s", ticket.ID, ticket.Status)
+	}
+	booking, err := uc.bookings.Get(ctx, ticket.BookingID)
+	if err != nil {
+		return err
+	}
+	if booking.Status != bookingv1.BookingStatus_CONFIRMED {
+		return bookingv1.ErrorTicketNotValid("booking %d is %s", booking.Id, booking.Status)
+	}
+
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: ticket.EventID})
+	if err != nil {
+		return fmt.Errorf("event not found")
+	}
+	start, err := time.Parse(time.RFC3339, evResp.ShowEvent.Date)
+	if err != nil {
+		return fmt.Errorf("event %d has an unreadable date %q", ticket.EventID, evResp.ShowEvent.Date)
+	}
+	now := time.Now()
+	if opens := start.Add(-uc.policy.OpensBefore); now.Before(opens) {
+		return bookingv1.ErrorCheckInClosed("check-in for event %d opens at %s", ticket.EventID, opens.Format(time.RFC3339))
+	}
+	if closes := start.Add(uc.policy.ClosesAfter); now.After(closes) {
+		return bookingv1.ErrorCheckInClosed("check-in for event %d closed at %s", ticket.EventID, closes.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// GetAttendance counts the event's admissions straight from the tickets,
+// so it is always current.
+func (uc *CheckInUsecase) GetAttendance(ctx context.Context, eventID uint64) (*Attendance, error) {
+	return uc.tickets.Attendance(ctx, eventID)
+}
@@ -0,0 +1,106 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LeaseRepo hands out short, named leases so that background work runs on
+// one replica at a time.
+type LeaseRepo interface {
+	// Acquire takes the lease for ttl and reports whether it was free.
+	Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error)
+}
+
+// ExpiryPolicy controls the sweep of abandoned PENDING bookings.
+type ExpiryPolicy struct {
+	Interval    time.Duration
+	GracePeriod time.Duration
+	BatchSize   int
+}
+
+// ProvideExpiryPolicy reads the expiry policy from config, falling back to
+// a sweep every 30 seconds that expires bookings pending for 15 minutes.
+func ProvideExpiryPolicy(c *conf.Data) *ExpiryPolicy {
+	p := &ExpiryPolicy{
+		Interval:    30 * time.Second,
+		GracePeriod: 15 * time.Minute,
+		BatchSize:   100,
+	}
+	e := c.GetPendingExpiry()
+	if e == nil {
+		return p
+	}
+	if e.Interval != nil && e.Interval.AsDuration() > 0 {
+		p.Interval = e.Interval.AsDuration()
+	}
+	if e.GracePeriod != nil && e.GracePeriod.AsDuration() > 0 {
+		p.GracePeriod = e.GracePeriod.AsDuration()
+	}
+	if e.BatchSize > 0 {
+		p.BatchSize = int(e.BatchSize)
+	}
+	return p
+}
+
+// ExpiryUsecase moves PENDING bookings that were never paid to EXPIRED.
+type ExpiryUsecase struct {
+	bookings *BookingUsecase
+	repo     BookingRepo
+	leases   LeaseRepo
+	policy   *ExpiryPolicy
+	log      *log.Helper
+}
+
+func NewExpiryUsecase(bookings *BookingUsecase, repo BookingRepo, leases LeaseRepo, policy *ExpiryPolicy, logger log.Logger) *ExpiryUsecase {
+	return &ExpiryUsecase{
+		bookings: bookings,
+		repo:     repo,
+		leases:   leases,
+		policy:   policy,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Interval is how often Sweep should run.
+func (uc *ExpiryUsecase) Interval() time.Duration {
+	return uc.policy.Interval
+}
+
+// Sweep expires one batch of stale PENDING bookings and returns how many it
+// expired. Only the replica holding the sweep lease does any work; the
+// status update is conditional as well, so a booking that is paid for or
+// expired elsewhere in the meantime is skipped.
+func (uc *ExpiryUsecase) Sweep(ctx context.Context) (int, error) {
+	ctx, release, ok, err := holdLease(ctx, uc.leases, "pending-expiry", uc.policy.Interval)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer release()
+	cutoff := time.Now().Add(-uc.policy.GracePeriod)
+	stale, err := uc.repo.ListPendingBefore(ctx, cutoff, uc.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	by := BySystem("pending-expiry", fmt.Sprintf("not paid within %s", uc.policy.GracePeriod))
+	expired := 0
+	for _, b := range stale {
+		if _, err := uc.bookings.UpdateStatus(ctx, b.Id, bookingv1.BookingStatus_EXPIRED, by); err != nil {
+			if !bookingv1.IsInvalidStatusTransition(err) {
+				uc.log.Errorf("Failed to expire booking %d: %v", b.Id, err)
+			}
+			continue
+		}
+		expired++
+	}
+	if expired > 0 {
+		uc.log.Infof("Expired %d PENDING bookings created before %s", expired, cutoff.Format(time.RFC3339))
+	}
+	return expired, nil
+}
@@ -0,0 +1,82 @@
+package biz
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+)
+
+// StatusChange is one move of a booking from one status to another. A
+// booking's first change is its creation, from BOOKING_STATUS_UNSPECIFIED.
+type StatusChange struct {
+	ID        uint64
+	BookingID uint64
+	From      bookingv1.BookingStatus
+	To        bookingv1.BookingStatus
+	Actor     bookingv1.BookingActor
+	ActorID   string
+	Reason    string
+	ChangedAt time.Time
+}
+
+// StatusHistoryRepo stores the status changes of bookings. Changes are
+// never updated or deleted.
+type StatusHistoryRepo interface {
+	// Record saves change. It is called in the transaction that moves the
+	// booking, so the history cannot miss a move or show one that rolled
+	// back.
+	Record(ctx context.Context, change *StatusChange) error
+	// ListByBooking returns the booking's changes, oldest first.
+	ListByBooking(ctx context.Context, bookingID uint64) ([]*StatusChange, error)
+}
+
+// StatusActor is who moves a booking, and why.
+type StatusActor struct {
+	Actor  bookingv1.BookingActor
+	ID     string
+	Reason string
+}
+
+// ByUser is a move made by userID, or by an unknown user if it is 0.
+func ByUser(userID uint64, reason string) StatusActor {
+	by := StatusActor{Actor: bookingv1.BookingActor_ACTOR_USER, Reason: reason}
+	if userID != 0 {
+		by.ID = strconv.FormatUint(userID, 10)
+	}
+	return by
+}
+
+// ByPayment is a move made by paymentservice once payment paymentID
+// settled.
+func ByPayment(paymentID uint64, reason string) StatusActor {
+	return StatusActor{Actor: bookingv1.BookingActor_ACTOR_PAYMENT, ID: strconv.FormatUint(paymentID, 10), Reason: reason}
+}
+
+// BySystem is a move made by the background job named job.
+func BySystem(job, reason string) StatusActor {
+	return StatusActor{Actor: bookingv1.BookingActor_ACTOR_SYSTEM, ID: job, Reason: reason}
+}
+
+// recordStatus adds the move of booking id from `from` to `to` to its
+// history. It must run in the transaction that makes the move.
+func (uc *BookingUsecase) recordStatus(ctx context.Context, id uint64, from, to bookingv1.BookingStatus, by StatusActor) error {
+	return uc.history.Record(ctx, &StatusChange{
+		BookingID: id,
+		From:      from,
+		To:        to,
+		Actor:     by.Actor,
+		ActorID:   by.ID,
+		Reason:    by.Reason,
+		ChangedAt: time.Now(),
+	})
+}
+
+// GetHistory returns the status changes of booking id, oldest first.
+func (uc *BookingUsecase) GetHistory(ctx context.Context, id uint64) ([]*StatusChange, error) {
+	if _, err := uc.repo.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.history.ListByBooking(ctx, id)
+}
@@ -0,0 +1,76 @@
+package biz
+
+import (
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+)
+
+// ErrHoldLimitReached is returned when a seat hold has used up its
+// extensions or its maximum length.
+var ErrHoldLimitReached = bookingv1.ErrorHoldLimitReached("seat hold cannot be extended any further")
+
+// SeatHold identifies the owner of a seat lock. The user id comes from the
+// client, so only the token proves ownership: an empty Token matches no
+// hold at all.
+type SeatHold struct {
+	UserID uint64
+	Token  string
+}
+
+// HeldBy reports whether hold h belongs to caller, i.e. caller has its
+// token.
+func (h SeatHold) HeldBy(caller SeatHold) bool {
+	return caller.Token != "" && h.UserID == caller.UserID && h.Token == caller.Token
+}
+
+// LockedSeat is an active hold on one seat of an event.
+type LockedSeat struct {
+	SeatID    string
+	Owner     SeatHold
+	ExpiresAt time.Time
+}
+
+// HoldResult is the outcome of taking or extending a hold. When Conflicts
+// is non-empty nothing was changed and the other fields are zero.
+type HoldResult struct {
+	Token      string
+	Conflicts  []string
+	ExpiresAt  time.Time
+	Extensions int32
+}
+
+// HoldPolicy bounds how long seats may stay held.
+type HoldPolicy struct {
+	TTL           time.Duration // length of a new hold and of each extension
+	MaxHold       time.Duration // total length of a hold, extensions included
+	MaxExtensions int32
+}
+
+// ProvideHoldPolicy reads the hold policy from config, falling back to a
+// 2 minute hold that can be extended 3 times up to 10 minutes.
+func ProvideHoldPolicy(c *conf.Data) *HoldPolicy {
+	p := &HoldPolicy{
+		TTL:           2 * time.Minute,
+		MaxHold:       10 * time.Minute,
+		MaxExtensions: 3,
+	}
+	h := c.GetSeatHold()
+	if h == nil {
+		return p
+	}
+	if h.Ttl != nil && h.Ttl.AsDuration() > 0 {
+		p.TTL = h.Ttl.AsDuration()
+	}
+	if h.MaxHold != nil && h.MaxHold.AsDuration() > 0 {
+		p.MaxHold = h.MaxHold.AsDuration()
+	}
+	if h.MaxExtensions > 0 {
+		p.MaxExtensions = h.MaxExtensions
+	}
+	if p.MaxHold < p.TTL {
+		p.MaxHold = p.TTL
+	}
+	return p
+}
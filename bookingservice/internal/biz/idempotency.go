@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idempotencyKeyTTL is how long a key and its stored response are kept.
+	idempotencyKeyTTL = 24 * time.Hour
+	// idempotencyClaimTimeout is how long a claimed key may go without a
+	// response before another request may take it over.
+	idempotencyClaimTimeout = time.Minute
+)
+
+// IdempotencyRecord is what is stored against an idempotency key.
+type IdempotencyRecord struct {
+	Fingerprint string
+	// Response is empty while the first request is still in progress.
+	Response  []byte
+	CreatedAt time.Time
+}
+
+// IdempotencyRepo stores idempotency keys with the request fingerprint and
+// the first response.
+type IdempotencyRepo interface {
+	// Claim reserves key within scope for a request with the given
+	// fingerprint. If the key is already taken it returns the existing
+	// record and false. Records older than ttl, and claims left without a
+	// response for claimTimeout, are taken over.
+	Claim(ctx context.Context, scope, key, fingerprint string, ttl, claimTimeout time.Duration) (*IdempotencyRecord, bool, error)
+	// Complete stores the response for a claimed key.
+	Complete(ctx context.Context, scope, key string, response []byte) error
+	// Release drops a claim whose request failed, so it can be retried.
+	Release(ctx context.Context, scope, key string) error
+}
+
+// runIdempotent runs fn once per scope and key and returns its encoded
+// response. A repeat with the same fingerprint gets the stored response; a
+// different fingerprint, or a repeat while fn is still running, gets a
+// conflict error. If fn fails the key is released.
+func runIdempotent(ctx context.Context, repo IdempotencyRepo, scope, key, fingerprint string, fn func() ([]byte, error)) ([]byte, bool, error) {
+	existing, claimed, err := repo.Claim(ctx, scope, key, fingerprint, idempotencyKeyTTL, idempotencyClaimTimeout)
+	if err != nil {
+		return nil, false, err
+	}
+	if !claimed {
+		if existing.Fingerprint != fingerprint {
+			return nil, false, bookingv1.ErrorIdempotencyKeyReused("idempotency key %q was already used with a different request", key)
+		}
+		if len(existing.Response) == 0 {
+			return nil, false, bookingv1.ErrorIdempotencyRequestInProgress("a request with idempotency key %q is still in progress", key)
+		}
+		return existing.Response, true, nil
+	}
+
+	res, err := fn()
+	if err != nil {
+		if rerr := repo.Release(ctx, scope, key); rerr != nil {
+			return nil, false, rerr
+		}
+		return nil, false, err
+	}
+	if err := repo.Complete(ctx, scope, key, res); err != nil {
+		return nil, false, err
+	}
+	return res, false, nil
+}
+
+// fingerprint hashes a request message deterministically.
+func fingerprint(m proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,295 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"bookingservice/internal/conf"
+	eventv1 "eventservice/api/eventservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// reconcileSettle is how long a repair waits before checking the bookings
+// again. A cancellation gives the seats back before its transaction
+// commits, so a count that is briefly ahead of the bookings is left alone.
+const reconcileSettle = 2 * time.Second
+
+// reconcileRepairTimeout bounds the repairs Reconcile leaves running in the
+// background.
+const reconcileRepairTimeout = time.Minute
+
+// Reasons a drifted event is not repaired.
+const (
+	SkippedInFlight      = "confirmation_in_flight" // a saga has taken seats its booking does not show yet
+	SkippedBookingsMoved = "bookings_changed"       // the CONFIRMED seats changed while checking
+	SkippedCountMoved    = "count_changed"          // the event's count was adjusted while checking
+	SkippedRepairFailed  = "repair_failed"          // the event service could not be reached
+)
+
+// InventoryPolicy controls the periodic inventory check.
+type InventoryPolicy struct {
+	Interval time.Duration
+	// Repair makes the periodic check fix the drift it finds, rather than
+	// only log it.
+	Repair bool
+}
+
+// ProvideInventoryPolicy reads the inventory check policy from config,
+// falling back to reporting drift every 10 minutes without repairing it.
+func ProvideInventoryPolicy(c *conf.Data) *InventoryPolicy {
+	p := &InventoryPolicy{Interval: 10 * time.Minute}
+	i := c.GetInventory()
+	if i == nil {
+		return p
+	}
+	if i.ReconcileInterval != nil && i.ReconcileInterval.AsDuration() > 0 {
+		p.Interval = i.ReconcileInterval.AsDuration()
+	}
+	p.Repair = i.Repair
+	return p
+}
+
+// InventoryDrift is an event whose available seat count does not match its
+// bookings.
+type InventoryDrift struct {
+	EventID           uint64
+	TotalSeats        int32
+	RecordedAvailable int32
+	// ExpectedAvailable is the total less the seats of CONFIRMED bookings,
+	// the only bookings whose seats come off the count.
+	ExpectedAvailable int32
+	ConfirmedSeats    int32
+	HeldSeats         int32
+	Drift             int32
+	Repaired          bool
+	Skipped           string
+	// Scheduled is set when the repair was left running in the background.
+	Scheduled bool
+}
+
+// InventoryReport is the outcome of a reconciliation.
+type InventoryReport struct {
+	Checked   int
+	Drifts    []*InventoryDrift
+	Repaired  int
+	Scheduled int
+}
+
+// InventoryUsecase finds and repairs drift between the events' available
+// seat counts and the bookings.
+type InventoryUsecase struct {
+	repo        BookingRepo
+	sagas       SagaRepo
+	leases      LeaseRepo
+	eventClient eventv1.EventServiceClient
+	policy      *InventoryPolicy
+	settle      time.Duration
+	log         *log.Helper
+}
+
+func NewInventoryUsecase(repo BookingRepo, sagas SagaRepo, leases LeaseRepo, eventClient eventv1.EventServiceClient, policy *InventoryPolicy, logger log.Logger) *InventoryUsecase {
+	return &InventoryUsecase{
+		repo:        repo,
+		sagas:       sagas,
+		leases:      leases,
+		eventClient: eventClient,
+		policy:      policy,
+		settle:      reconcileSettle,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Interval is how often Process should run.
+func (uc *InventoryUsecase) Interval() time.Duration {
+	return uc.policy.Interval
+}
+
+// Reconcile checks eventIDs, or every event if there are none, and unless
+// dryRun repairs the counts that drifted. The repairs wait reconcileSettle
+// for the bookings to settle, longer than a request may take, so they are
+// left running in the background and only logged; the report counts them
+// as scheduled.
+func (uc *InventoryUsecase) Reconcile(ctx context.Context, eventIDs []uint64, dryRun bool) (*InventoryReport, error) {
+	report, repairs, err := uc.find(ctx, eventIDs)
+	if err != nil || dryRun || len(repairs) == 0 {
+		return report, err
+	}
+	pending := make([]*InventoryDrift, 0, len(repairs))
+	for _, d := range repairs {
+		d.Scheduled = true
+		c := *d
+		pending = append(pending, &c)
+	}
+	report.Scheduled = len(pending)
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), reconcileRepairTimeout)
+		defer cancel()
+		if _, err := uc.settleAndRepair(ctx, pending); err != nil {
+			uc.log.Errorf("Inventory repair stopped: %v", err)
+		}
+		uc.logDrifts(pending)
+	}()
+	return report, nil
+}
+
+// find checks eventIDs, or every event if there are none, and returns the
+// report and the drifts that may be repaired.
+func (uc *InventoryUsecase) find(ctx context.Context, eventIDs []uint64) (*InventoryReport, []*InventoryDrift, error) {
+	events, err := uc.listEvents(ctx, eventIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	inFlight, err := uc.sagas.ListInFlightEvents(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	busy := make(map[uint64]bool, len(inFlight))
+	for _, id := range inFlight {
+		busy[id] = true
+	}
+
+	report := &InventoryReport{Checked: len(events)}
+	var repairs []*InventoryDrift
+	for _, ev := range events {
+		d, err := uc.check(ctx, ev)
+		if err != nil {
+			return nil, nil, err
+		}
+		if d.Drift == 0 {
+			continue
+		}
+		report.Drifts = append(report.Drifts, d)
+		if busy[ev.Id] {
+			d.Skipped = SkippedInFlight
+			continue
+		}
+		repairs = append(repairs, d)
+	}
+	return report, repairs, nil
+}
+
+// settleAndRepair waits for the bookings to settle and then repairs drifts,
+// each only if the event's CONFIRMED seats are the same as when it was
+// found and nothing adjusted its count in the meantime. It returns how
+// many it repaired.
+func (uc *InventoryUsecase) settleAndRepair(ctx context.Context, drifts []*InventoryDrift) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(uc.settle):
+	}
+	repaired := 0
+	for _, d := range drifts {
+		if uc.repair(ctx, d) {
+			repaired++
+		}
+	}
+	return repaired, nil
+}
+
+func (uc *InventoryUsecase) listEvents(ctx context.Context, eventIDs []uint64) ([]*eventv1.ShowEvent, error) {
+	if len(eventIDs) == 0 {
+		resp, err := uc.eventClient.ListShowEvents(ctx, &eventv1.ListShowEventsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return resp.ShowEvents, nil
+	}
+	events := make([]*eventv1.ShowEvent, 0, len(eventIDs))
+	for _, id := range eventIDs {
+		resp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: id})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.ShowEvent)
+	}
+	return events, nil
+}
+
+// check compares ev's count with its bookings.
+func (uc *InventoryUsecase) check(ctx context.Context, ev *eventv1.ShowEvent) (*InventoryDrift, error) {
+	booked, err := uc.repo.ListBookedSeats(ctx, ev.Id)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := uc.repo.GetLockedSeats(ctx, ev.Id)
+	if err != nil {
+		return nil, err
+	}
+	expected := ev.TotalSeats - int32(len(booked))
+	return &InventoryDrift{
+		EventID:           ev.Id,
+		TotalSeats:        ev.TotalSeats,
+		RecordedAvailable: ev.AvailableSeats,
+		ExpectedAvailable: expected,
+		ConfirmedSeats:    int32(len(booked)),
+		HeldSeats:         int32(len(locked)),
+		Drift:             ev.AvailableSeats - expected,
+	}, nil
+}
+
+// repair sets d's event count to what its bookings say, and reports
+// whether it did. An overbooked event's count goes to 0.
+func (uc *InventoryUsecase) repair(ctx context.Context, d *InventoryDrift) bool {
+	booked, err := uc.repo.ListBookedSeats(ctx, d.EventID)
+	if err != nil {
+		uc.log.Errorf("Failed to recheck the bookings of event %d: %v", d.EventID, err)
+		d.Skipped = SkippedRepairFailed
+		return false
+	}
+	if int32(len(booked)) != d.ConfirmedSeats {
+		d.Skipped = SkippedBookingsMoved
+		return false
+	}
+	target := d.ExpectedAvailable
+	if target < 0 {
+		target = 0
+	}
+	resp, err := uc.eventClient.SetAvailableSeats(ctx, &eventv1.SetAvailableSeatsRequest{
+		EventId:                d.EventID,
+		ExpectedAvailableSeats: d.RecordedAvailable,
+		AvailableSeats:         target,
+	})
+	if err != nil {
+		uc.log.Errorf("Failed to repair the seat count of event %d: %v", d.EventID, err)
+		d.Skipped = SkippedRepairFailed
+		return false
+	}
+	if !resp.Updated {
+		d.Skipped = SkippedCountMoved
+		return false
+	}
+	d.Repaired = true
+	uc.log.Infof("Repaired the seat count of event %d: available %d -> %d", d.EventID, d.RecordedAvailable, target)
+	return true
+}
+
+// Process runs the periodic check, repairing drift only if the policy says
+// so. Only the replica holding the inventory lease does any work.
+func (uc *InventoryUsecase) Process(ctx context.Context) (*InventoryReport, error) {
+	ctx, release, ok, err := holdLease(ctx, uc.leases, "inventory-reconcile", uc.policy.Interval)
+	if err != nil || !ok {
+		return nil, err
+	}
+	defer release()
+	report, repairs, err := uc.find(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if uc.policy.Repair && len(repairs) > 0 {
+		if report.Repaired, err = uc.settleAndRepair(ctx, repairs); err != nil {
+			return nil, err
+		}
+	}
+	uc.logDrifts(report.Drifts)
+	return report, nil
+}
+
+func (uc *InventoryUsecase) logDrifts(drifts []*InventoryDrift) {
+	for _, d := range drifts {
+		uc.log.Warnf("Event %d seat count drifted by %d: recorded=%d expected=%d repaired=%t skipped=%q",
+			d.EventID, d.Drift, d.RecordedAvailable, d.ExpectedAvailable, d.Repaired, d.Skipped)
+	}
+}
@@ -0,0 +1,215 @@
+package biz
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	eventv1 "eventservice/api/eventservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeBookingRepo serves the booked and held seats of events; every other
+// BookingRepo method panics.
+type fakeBookingRepo struct {
+	BookingRepo
+	booked map[uint64][]string
+	locked map[uint64][]*LockedSeat
+}
+
+func (r *fakeBookingRepo) ListBookedSeats(ctx context.Context, eventID uint64) ([]string, error) {
+	return r.booked[eventID], nil
+}
+
+func (r *fakeBookingRepo) GetLockedSeats(ctx context.Context, eventID uint64) ([]*LockedSeat, error) {
+	return r.locked[eventID], nil
+}
+
+type fakeSagaRepo struct {
+	SagaRepo
+	inFlight []uint64
+}
+
+func (r *fakeSagaRepo) ListInFlightEvents(ctx context.Context) ([]uint64, error) {
+	return r.inFlight, nil
+}
+
+type fakeLeaseRepo struct{}
+
+func (fakeLeaseRepo) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return true, nil
+}
+
+// fakeEventClient keeps events in memory and applies SetAvailableSeats as
+// a compare-and-set, reporting each call on sets.
+type fakeEventClient struct {
+	eventv1.EventServiceClient
+	mu     sync.Mutex
+	events map[uint64]*eventv1.ShowEvent
+	sets   chan *eventv1.SetAvailableSeatsRequest
+}
+
+func newFakeEventClient(events ...*eventv1.ShowEvent) *fakeEventClient {
+	c := &fakeEventClient{
+		events: make(map[uint64]*eventv1.ShowEvent),
+		sets:   make(chan *eventv1.SetAvailableSeatsRequest, 16),
+	}
+	for _, ev := range events {
+		c.events[ev.Id] = ev
+	}
+	return c
+}
+
+func (c *fakeEventClient) GetShowEvent(ctx context.Context, in *eventv1.GetShowEventRequest, opts ...grpc.CallOption) (*eventv1.ShowEventReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ev := proto.Clone(c.events[in.Id]).(*eventv1.ShowEvent)
+	return &eventv1.ShowEventReply{ShowEvent: ev}, nil
+}
+
+func (c *fakeEventClient) ListShowEvents(ctx context.Context, in *eventv1.ListShowEventsRequest, opts ...grpc.CallOption) (*eventv1.ListShowEventsReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	reply := &eventv1.ListShowEventsReply{}
+	for _, ev := range c.events {
+		reply.ShowEvents = append(reply.ShowEvents, proto.Clone(ev).(*eventv1.ShowEvent))
+	}
+	return reply, nil
+}
+
+func (c *fakeEventClient) SetAvailableSeats(ctx context.Context, in *eventv1.SetAvailableSeatsRequest, opts ...grpc.CallOption) (*eventv1.SetAvailableSeatsReply, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ev := c.events[in.EventId]
+	updated := ev.AvailableSeats == in.ExpectedAvailableSeats
+	if updated {
+		ev.AvailableSeats = in.AvailableSeats
+	}
+	c.sets <- in
+	return &eventv1.SetAvailableSeatsReply{Updated: updated, AvailableSeats: ev.AvailableSeats}, nil
+}
+
+func (c *fakeEventClient) available(id uint64) int32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.events[id].AvailableSeats
+}
+
+func newTestInventoryUsecase(repo BookingRepo, sagas SagaRepo, events *fakeEventClient, repair bool) *InventoryUsecase {
+	uc := NewInventoryUsecase(repo, sagas, fakeLeaseRepo{}, events, &InventoryPolicy{Interval: time.Minute, Repair: repair}, log.NewStdLogger(io.Discard))
+	uc.settle = 0
+	return uc
+}
+
+func TestInventoryProcessRepairs(t *testing.T) {
+	tests := []struct {
+		name          string
+		available     int32
+		booked        []string
+		inFlight      []uint64
+		wantAvailable int32
+		wantRepaired  int
+		wantSkipped   string
+	}{
+		{name: "count behind", available: 5, booked: []string{"A1", "A2"}, wantAvailable: 8, wantRepaired: 1},
+		{name: "count ahead", available: 10, booked: []string{"A1"}, wantAvailable: 9, wantRepaired: 1},
+		{name: "overbooked", available: 1, booked: []string{"A1", "A2", "A3", "A4", "A5", "A6", "A7", "A8", "A9", "A10", "A11"}, wantAvailable: 0, wantRepaired: 1},
+		{name: "in flight", available: 5, booked: []string{"A1"}, inFlight: []uint64{1}, wantAvailable: 5, wantSkipped: SkippedInFlight},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := newFakeEventClient(&eventv1.ShowEvent{Id: 1, TotalSeats: 10, AvailableSeats: tt.available})
+			repo := &fakeBookingRepo{booked: map[uint64][]string{1: tt.booked}}
+			uc := newTestInventoryUsecase(repo, &fakeSagaRepo{inFlight: tt.inFlight}, events, true)
+
+			report, err := uc.Process(context.Background())
+			if err != nil {
+				t.Fatalf("Process: %v", err)
+			}
+			if report.Repaired != tt.wantRepaired {
+				t.Errorf("Repaired = %d, want %d", report.Repaired, tt.wantRepaired)
+			}
+			if len(report.Drifts) != 1 || report.Drifts[0].Skipped != tt.wantSkipped {
+				t.Errorf("Drifts = %+v, want one skipped %q", report.Drifts, tt.wantSkipped)
+			}
+			if got := events.available(1); got != tt.wantAvailable {
+				t.Errorf("available seats = %d, want %d", got, tt.wantAvailable)
+			}
+		})
+	}
+}
+
+func TestInventoryProcessLeavesCountMovedByOthers(t *testing.T) {
+	events := newFakeEventClient(&eventv1.ShowEvent{Id: 1, TotalSeats: 10, AvailableSeats: 5})
+	repo := &fakeBookingRepo{booked: map[uint64][]string{1: {"A1"}}}
+	uc := newTestInventoryUsecase(repo, &fakeSagaRepo{}, events, true)
+
+	// A booking changes the count between the check and the repair
+	report, repairs, err := uc.find(context.Background(), []uint64{1})
+	if err != nil {
+		t.Fatalf("find: %v", err)
+	}
+	events.events[1].AvailableSeats = 4
+	if n, err := uc.settleAndRepair(context.Background(), repairs); err != nil || n != 0 {
+		t.Fatalf("settleAndRepair = %d, %v; want 0, nil", n, err)
+	}
+	if report.Drifts[0].Skipped != SkippedCountMoved {
+		t.Errorf("Skipped = %q, want %q", report.Drifts[0].Skipped, SkippedCountMoved)
+	}
+	if got := events.available(1); got != 4 {
+		t.Errorf("available seats = %d, want 4", got)
+	}
+}
+
+func TestInventoryReconcileSchedulesRepair(t *testing.T) {
+	events := newFakeEventClient(&eventv1.ShowEvent{Id: 1, TotalSeats: 10, AvailableSeats: 6})
+	repo := &fakeBookingRepo{booked: map[uint64][]string{1: {"A1", "A2"}}}
+	uc := newTestInventoryUsecase(repo, &fakeSagaRepo{}, events, false)
+
+	// The request's deadline must not cut the background repair short
+	ctx, cancel := context.WithCancel(context.Background())
+	report, err := uc.Reconcile(ctx, []uint64{1}, false)
+	cancel()
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if report.Scheduled != 1 || !report.Drifts[0].Scheduled || report.Repaired != 0 {
+		t.Fatalf("report = %+v, want one scheduled repair", report)
+	}
+
+	select {
+	case set := <-events.sets:
+		if set.ExpectedAvailableSeats != 6 || set.AvailableSeats != 8 {
+			t.Errorf("SetAvailableSeats(expected %d, available %d), want (6, 8)", set.ExpectedAvailableSeats, set.AvailableSeats)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the repair never ran")
+	}
+	if got := events.available(1); got != 8 {
+		t.Errorf("available seats = %d, want 8", got)
+	}
+}
+
+func TestInventoryReconcileDryRun(t *testing.T) {
+	events := newFakeEventClient(&eventv1.ShowEvent{Id: 1, TotalSeats: 10, AvailableSeats: 6})
+	repo := &fakeBookingRepo{booked: map[uint64][]string{1: {"A1", "A2"}}}
+	uc := newTestInventoryUsecase(repo, &fakeSagaRepo{}, events, false)
+
+	report, err := uc.Reconcile(context.Background(), []uint64{1}, true)
+	if err != nil {
+		t.Fatalf("Reconcile: %v", err)
+	}
+	if len(report.Drifts) != 1 || report.Drifts[0].Drift != -2 || report.Scheduled != 0 {
+		t.Fatalf("report = %+v, want one drift of -2 and nothing scheduled", report)
+	}
+	select {
+	case <-events.sets:
+		t.Fatal("a dry run changed the count")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
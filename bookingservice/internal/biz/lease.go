@@ -0,0 +1,49 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrLeaseLost is the cause of a leased context cancelled because another
+// replica took the lease.
+var ErrLeaseLost = errors.New("lease lost to another replica")
+
+// holdLease takes lease name for ttl and keeps renewing it every third of
+// ttl until release returns, so the lease outlives a pass however long
+// the pass takes. The returned context is cancelled with ErrLeaseLost if a
+// renewal fails, which stops the pass before another replica repeats its
+// work. ok is false, and there is nothing to release, if another replica
+// holds the lease.
+func holdLease(ctx context.Context, leases LeaseRepo, name string, ttl time.Duration) (leased context.Context, release func(), ok bool, err error) {
+	ok, err = leases.Acquire(ctx, name, ttl)
+	if err != nil || !ok {
+		return ctx, func() {}, false, err
+	}
+	leased, cancel := context.WithCancelCause(ctx)
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-leased.Done():
+				return
+			case <-ticker.C:
+				if ok, err := leases.Acquire(leased, name, ttl); err != nil || !ok {
+					cancel(ErrLeaseLost)
+					return
+				}
+			}
+		}
+	}()
+	return leased, func() {
+		close(done)
+		<-stopped
+		cancel(nil)
+	}, true, nil
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// countingLeaseRepo grants the lease the first grants times it is asked.
+type countingLeaseRepo struct {
+	mu     sync.Mutex
+	grants int
+	calls  int
+}
+
+func (r *countingLeaseRepo) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	return r.calls <= r.grants, nil
+}
+
+func (r *countingLeaseRepo) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func TestHoldLeaseRenewsUntilReleased(t *testing.T) {
+	leases := &countingLeaseRepo{grants: 100}
+	ctx, release, ok, err := holdLease(context.Background(), leases, "test", 30*time.Millisecond)
+	if err != nil || !ok {
+		t.Fatalf("holdLease = %t, %v; want true, nil", ok, err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if ctx.Err() != nil {
+		t.Fatalf("leased context ended while the lease was held: %v", context.Cause(ctx))
+	}
+	if n := leases.count(); n < 3 {
+		t.Errorf("lease taken %d times in 100ms with a 30ms ttl, want it renewed", n)
+	}
+	release()
+	n := leases.count()
+	time.Sleep(50 * time.Millisecond)
+	if leases.count() != n {
+		t.Error("lease still renewed after release")
+	}
+}
+
+func TestHoldLeaseCancelsWhenLost(t *testing.T) {
+	leases := &countingLeaseRepo{grants: 1}
+	ctx, release, ok, err := holdLease(context.Background(), leases, "test", 30*time.Millisecond)
+	if err != nil || !ok {
+		t.Fatalf("holdLease = %t, %v; want true, nil", ok, err)
+	}
+	defer release()
+	select {
+	case <-ctx.Done():
+		if !errors.Is(context.Cause(ctx), ErrLeaseLost) {
+			t.Errorf("cause = %v, want ErrLeaseLost", context.Cause(ctx))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("leased context not cancelled after the lease was lost")
+	}
+}
+
+func TestHoldLeaseHeldElsewhere(t *testing.T) {
+	_, release, ok, err := holdLease(context.Background(), &countingLeaseRepo{}, "test", time.Second)
+	if err != nil || ok {
+		t.Fatalf("holdLease = %t, %v; want false, nil", ok, err)
+	}
+	release()
+}
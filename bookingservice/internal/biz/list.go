@@ -0,0 +1,110 @@
+package biz
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// BookingFilter selects and orders bookings for a list page. Zero fields do
+// not filter.
+type BookingFilter struct {
+	UserID        uint64
+	EventID       uint64
+	Status        bookingv1.BookingStatus
+	CreatedAfter  time.Time // inclusive
+	CreatedBefore time.Time // exclusive
+	Sort          bookingv1.BookingSort
+	// After is the position of the last booking of the previous page.
+	After *BookingCursor
+	Limit int
+}
+
+// BookingCursor is a position in a sorted booking list.
+type BookingCursor struct {
+	Sort      bookingv1.BookingSort `json:"s"`
+	CreatedAt time.Time             `json:"c"`
+	TotalCost int64                 `json:"t"`
+	ID        uint64                `json:"i"`
+}
+
+// BookingPage is one page of a booking list.
+type BookingPage struct {
+	Bookings []*bookingv1.Booking
+	// Next is the cursor of the last booking, or nil on the last page.
+	Next *BookingCursor
+}
+
+func encodePageToken(c *BookingCursor) string {
+	if c == nil {
+		return ""
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string, sort bookingv1.BookingSort) (*BookingCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, bookingv1.ErrorInvalidListRequest("malformed page token")
+	}
+	var c BookingCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, bookingv1.ErrorInvalidListRequest("malformed page token")
+	}
+	if c.Sort != sort {
+		return nil, bookingv1.ErrorInvalidListRequest("page token was issued for sort %s, not %s", c.Sort, sort)
+	}
+	return &c, nil
+}
+
+// ParseListTime parses an RFC3339 created_at bound; empty means unbounded.
+func ParseListTime(field, v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, bookingv1.ErrorInvalidListRequest("%s must be an RFC3339 timestamp", field)
+	}
+	return t, nil
+}
+
+// List returns one page of bookings matching filter, starting after the
+// position in pageToken, and the token of the next page.
+func (uc *BookingUsecase) List(ctx context.Context, filter BookingFilter, pageSize int32, pageToken string) ([]*bookingv1.Booking, string, error) {
+	if _, ok := bookingv1.BookingSort_name[int32(filter.Sort)]; !ok {
+		return nil, "", bookingv1.ErrorInvalidListRequest("unknown sort %d", filter.Sort)
+	}
+	after, err := decodePageToken(pageToken, filter.Sort)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.After = after
+
+	switch {
+	case pageSize <= 0:
+		filter.Limit = defaultPageSize
+	case pageSize > maxPageSize:
+		filter.Limit = maxPageSize
+	default:
+		filter.Limit = int(pageSize)
+	}
+
+	page, err := uc.repo.List(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return page.Bookings, encodePageToken(page.Next), nil
+}
@@ -0,0 +1,275 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+	moneyv1 "eventservice/api/money/v1"
+	notifv1 "notificationservice/api/notificationservice/v1"
+	paymentv1 "paymentservice/api/paymentservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Outbox topics. Status changes use "booking." plus the lower-cased status.
+const (
+	TopicBookingCreated = "booking.created"
+)
+
+// StatusTopic is the outbox topic for a booking moving to status.
+func StatusTopic(status bookingv1.BookingStatus) string {
+	return "booking." + strings.ToLower(status.String())
+}
+
+// OutboxMessage is a booking lifecycle event waiting to be delivered.
+// Waitlist messages carry WaitlistEntryID instead of BookingID;
+// TopicSeatsCancelled messages carry the SeatCancellationID,
+// TopicSeatsChanged messages the SeatChangeID and transfer messages the
+// TransferID as well.
+type OutboxMessage struct {
+	ID                 uint64
+	Topic              string
+	BookingID          uint64
+	WaitlistEntryID    uint64
+	SeatCancellationID uint64
+	SeatChangeID       uint64
+	TransferID         uint64
+	Attempts           int32
+}
+
+// OutboxAttempt is the outcome of one delivery attempt.
+type OutboxAttempt struct {
+	Delivered bool
+	// Detail is the delivery error, or the notifier's reply message.
+	Detail string
+	// NextAttemptAt is when to retry; zero means give up.
+	NextAttemptAt time.Time
+}
+
+// OutboxRepo stores outbox messages. Add must be called inside the
+// transaction that makes the change the message describes.
+type OutboxRepo interface {
+	Add(ctx context.Context, msg *OutboxMessage) error
+	// ListDue returns up to limit undelivered messages whose next attempt is
+	// due, oldest first.
+	ListDue(ctx context.Context, limit int) ([]*OutboxMessage, error)
+	// RecordAttempt logs an attempt for the message and updates its state.
+	RecordAttempt(ctx context.Context, id uint64, attempt OutboxAttempt) error
+}
+
+// OutboxPolicy controls the outbox relay.
+type OutboxPolicy struct {
+	Interval     time.Duration
+	BatchSize    int
+	MaxAttempts  int32
+	RetryBackoff time.Duration
+}
+
+// ProvideOutboxPolicy reads the relay policy from config, falling back to a
+// relay every 2 seconds that retries 8 times starting 5 seconds apart.
+func ProvideOutboxPolicy(c *conf.Data) *OutboxPolicy {
+	p := &OutboxPolicy{
+		Interval:     2 * time.Second,
+		BatchSize:    50,
+		MaxAttempts:  8,
+		RetryBackoff: 5 * time.Second,
+	}
+	o := c.GetOutbox()
+	if o == nil {
+		return p
+	}
+	if o.Interval != nil && o.Interval.AsDuration() > 0 {
+		p.Interval = o.Interval.AsDuration()
+	}
+	if o.BatchSize > 0 {
+		p.BatchSize = int(o.BatchSize)
+	}
+	if o.MaxAttempts > 0 {
+		p.MaxAttempts = o.MaxAttempts
+	}
+	if o.RetryBackoff != nil && o.RetryBackoff.AsDuration() > 0 {
+		p.RetryBackoff = o.RetryBackoff.AsDuration()
+	}
+	return p
+}
+
+// backoff is the delay before retrying after the given number of attempts.
+func (p *OutboxPolicy) backoff(attempts int32) time.Duration {
+	d := p.RetryBackoff
+	for i := int32(1); i < attempts && d < time.Hour; i++ {
+		d *= 2
+	}
+	if d > time.Hour {
+		d = time.Hour
+	}
+	return d
+}
+
+// OutboxUsecase relays outbox messages to notificationservice, and refund
+// requests to paymentservice.
+type OutboxUsecase struct {
+	repo               OutboxRepo
+	leases             LeaseRepo
+	cancellations      SeatCancellationRepo
+	seatChanges        SeatChangeRepo
+	notificationClient notifv1.NotificationServiceClient
+	paymentClient      paymentv1.PaymentServiceClient
+	policy             *OutboxPolicy
+	log                *log.Helper
+}
+
+func NewOutboxUsecase(repo OutboxRepo, leases LeaseRepo, cancellations SeatCancellationRepo, seatChanges SeatChangeRepo, notificationClient notifv1.NotificationServiceClient, paymentClient paymentv1.PaymentServiceClient, policy *OutboxPolicy, logger log.Logger) *OutboxUsecase {
+	return &OutboxUsecase{
+		repo:               repo,
+		leases:             leases,
+		cancellations:      cancellations,
+		seatChanges:        seatChanges,
+		notificationClient: notificationClient,
+		paymentClient:      paymentClient,
+		policy:             policy,
+		log:                log.NewHelper(logger),
+	}
+}
+
+// Interval is how often Relay should run.
+func (uc *OutboxUsecase) Interval() time.Duration {
+	return uc.policy.Interval
+}
+
+// Relay delivers one batch of due messages and returns how many were
+// delivered. Failed deliveries are retried with exponential backoff until
+// MaxAttempts, except refund requests, which are retried at most an hour
+// apart until they succeed. Only the replica holding the relay lease does
+// any work.
+func (uc *OutboxUsecase) Relay(ctx context.Context) (int, error) {
+	ctx, release, ok, err := holdLease(ctx, uc.leases, "outbox-relay", uc.policy.Interval)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer release()
+	due, err := uc.repo.ListDue(ctx, uc.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	delivered := 0
+	for _, msg := range due {
+		attempt := uc.deliver(ctx, msg)
+		if err := uc.repo.RecordAttempt(ctx, msg.ID, attempt); err != nil {
+			return delivered, err
+		}
+		if attempt.Delivered {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+func (uc *OutboxUsecase) deliver(ctx context.Context, msg *OutboxMessage) OutboxAttempt {
+	ctxDeliver, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	// notification service fetches the booking or entry and the email
+	subject := fmt.Sprintf("booking %d", msg.BookingID)
+	var (
+		detail string
+		err    error
+	)
+	switch msg.Topic {
+	case TopicWaitlistOffered:
+		subject = fmt.Sprintf("waitlist entry %d", msg.WaitlistEntryID)
+		var reply *notifv1.SendWaitlistOfferNotificationReply
+		reply, err = uc.notificationClient.SendWaitlistOfferNotification(ctxDeliver, &notifv1.SendWaitlistOfferNotificationRequest{
+			EntryId: msg.WaitlistEntryID,
+		})
+		detail = reply.GetMessage()
+	case TopicTransferStarted, TopicTransferAccepted:
+		subject = fmt.Sprintf("transfer %d", msg.TransferID)
+		var reply *notifv1.SendTransferNotificationReply
+		reply, err = uc.notificationClient.SendTransferNotification(ctxDeliver, &notifv1.SendTransferNotificationRequest{
+			TransferId: msg.TransferID,
+			Accepted:   msg.Topic == TopicTransferAccepted,
+		})
+		detail = reply.GetMessage()
+	case TopicSeatsCancelled:
+		detail, err = uc.requestRefund(ctxDeliver, msg)
+	case TopicSeatsChanged:
+		detail, err = uc.requestChangeRefund(ctxDeliver, msg)
+	default:
+		var reply *notifv1.SendBookingNotificationReply
+		reply, err = uc.notificationClient.SendBookingNotification(ctxDeliver, &notifv1.SendBookingNotificationRequest{
+			BookingId: msg.BookingID,
+		})
+		detail = reply.GetMessage()
+	}
+	if err == nil {
+		uc.log.Infof("Delivered %s for %s: %s", msg.Topic, subject, detail)
+		return OutboxAttempt{Delivered: true, Detail: detail}
+	}
+
+	attempts := msg.Attempts + 1
+	if attempts >= uc.policy.MaxAttempts && isRefundTopic(msg.Topic) {
+		// a refund is money owed to the customer, so it is never dropped
+		uc.log.Errorf("Refund %s for %s still failing after %d attempts, retrying: %v", msg.Topic, subject, attempts, err)
+		return OutboxAttempt{Detail: err.Error(), NextAttemptAt: time.Now().Add(uc.policy.backoff(attempts))}
+	}
+	if attempts >= uc.policy.MaxAttempts {
+		uc.log.Errorf("Giving up on %s for %s after %d attempts: %v", msg.Topic, subject, attempts, err)
+		return OutboxAttempt{Detail: err.Error()}
+	}
+	uc.log.Warnf("Failed to deliver %s for %s (attempt %d): %v", msg.Topic, subject, attempts, err)
+	return OutboxAttempt{Detail: err.Error(), NextAttemptAt: time.Now().Add(uc.policy.backoff(attempts))}
+}
+
+// isRefundTopic reports whether messages of topic request a refund.
+func isRefundTopic(topic string) bool {
+	return topic == TopicSeatsCancelled || topic == TopicSeatsChanged
+}
+
+// requestRefund asks paymentservice to refund a seat cancellation. The
+// cancellation id is the idempotency key, so a retried delivery does not
+// refund twice.
+func (uc *OutboxUsecase) requestRefund(ctx context.Context, msg *OutboxMessage) (string, error) {
+	c, err := uc.cancellations.Get(ctx, msg.SeatCancellationID)
+	if err != nil {
+		return "", err
+	}
+	if c.RefundAmount <= 0 {
+		return "nothing to refund", nil
+	}
+	reply, err := uc.paymentClient.RefundPayment(ctx, &paymentv1.RefundPaymentRequest{
+		BookingId:      c.BookingID,
+		Amount:         &moneyv1.Money{AmountMinor: c.RefundAmount, Currency: c.Currency},
+		Reason:         fmt.Sprintf("seats %s cancelled", strings.Join(c.SeatIDs, ", ")),
+		IdempotencyKey: fmt.Sprintf("seat-cancellation-%d", c.ID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("refund %d of %d %s", reply.GetRefundId(), reply.GetAmount().GetAmountMinor(), reply.GetAmount().GetCurrency()), nil
+}
+
+// requestChangeRefund asks paymentservice to refund what a seat change made
+// a booking cheaper, keyed by the seat change id like requestRefund.
+func (uc *OutboxUsecase) requestChangeRefund(ctx context.Context, msg *OutboxMessage) (string, error) {
+	c, err := uc.seatChanges.Get(ctx, msg.SeatChangeID)
+	if err != nil {
+		return "", err
+	}
+	if c.PriceDifference >= 0 {
+		return "nothing to refund", nil
+	}
+	reply, err := uc.paymentClient.RefundPayment(ctx, &paymentv1.RefundPaymentRequest{
+		BookingId:      c.BookingID,
+		Amount:         &moneyv1.Money{AmountMinor: -c.PriceDifference, Currency: c.Currency},
+		Reason:         fmt.Sprintf("seats %s changed to %s", strings.Join(c.OldSeatIDs, ", "), strings.Join(c.NewSeatIDs, ", ")),
+		IdempotencyKey: fmt.Sprintf("seat-change-%d", c.ID),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("refund %d of %d %s", reply.GetRefundId(), reply.GetAmount().GetAmountMinor(), reply.GetAmount().GetCurrency()), nil
+}
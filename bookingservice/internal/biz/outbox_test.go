@@ -0,0 +1,71 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	notifv1 "notificationservice/api/notificationservice/v1"
+	paymentv1 "paymentservice/api/paymentservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/grpc"
+)
+
+var errUnavailable = errors.New("unavailable")
+
+type fakeCancellationRepo struct {
+	SeatCancellationRepo
+}
+
+func (fakeCancellationRepo) Get(ctx context.Context, id uint64) (*SeatCancellation, error) {
+	return &SeatCancellation{ID: id, BookingID: 1, SeatIDs: []string{"A1"}, RefundAmount: 500, Currency: "EUR"}, nil
+}
+
+// downPaymentClient and downNotificationClient fail every call.
+type downPaymentClient struct {
+	paymentv1.PaymentServiceClient
+}
+
+func (downPaymentClient) RefundPayment(ctx context.Context, in *paymentv1.RefundPaymentRequest, opts ...grpc.CallOption) (*paymentv1.RefundPaymentReply, error) {
+	return nil, errUnavailable
+}
+
+type downNotificationClient struct {
+	notifv1.NotificationServiceClient
+}
+
+func (downNotificationClient) SendBookingNotification(ctx context.Context, in *notifv1.SendBookingNotificationRequest, opts ...grpc.CallOption) (*notifv1.SendBookingNotificationReply, error) {
+	return nil, errUnavailable
+}
+
+func TestOutboxDeliverGivesUpOnlyOnNotifications(t *testing.T) {
+	policy := &OutboxPolicy{MaxAttempts: 3, RetryBackoff: time.Second}
+	uc := NewOutboxUsecase(nil, fakeLeaseRepo{}, fakeCancellationRepo{}, nil, downNotificationClient{}, downPaymentClient{}, policy, log.NewStdLogger(io.Discard))
+	tests := []struct {
+		name      string
+		msg       *OutboxMessage
+		wantRetry bool
+	}{
+		{name: "notification before the last attempt", msg: &OutboxMessage{Topic: TopicBookingCreated, BookingID: 1, Attempts: 1}, wantRetry: true},
+		{name: "notification at the last attempt", msg: &OutboxMessage{Topic: TopicBookingCreated, BookingID: 1, Attempts: 2}},
+		{name: "refund at the last attempt", msg: &OutboxMessage{Topic: TopicSeatsCancelled, SeatCancellationID: 7, Attempts: 2}, wantRetry: true},
+		{name: "refund long after the last attempt", msg: &OutboxMessage{Topic: TopicSeatsCancelled, SeatCancellationID: 7, Attempts: 50}, wantRetry: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempt := uc.deliver(context.Background(), tt.msg)
+			if attempt.Delivered {
+				t.Fatal("delivered through a failing client")
+			}
+			if retry := !attempt.NextAttemptAt.IsZero(); retry != tt.wantRetry {
+				t.Errorf("retry = %v, want %v", retry, tt.wantRetry)
+			}
+			if tt.wantRetry && time.Until(attempt.NextAttemptAt) > time.Hour {
+				t.Errorf("next attempt in %v, want at most an hour", time.Until(attempt.NextAttemptAt))
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	moneyv1 "eventservice/api/money/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PromoCode discounts the bookings made with it.
+type PromoCode struct {
+	ID         uint64
+	Code       string
+	Type       bookingv1.PromoDiscountType
+	PercentOff int32
+	// AmountOff is the fixed discount in minor units of Currency.
+	AmountOff int64
+	Currency  string
+	// EventIDs are the events the code is good for; empty means all.
+	EventIDs []uint64
+	// ValidFrom and ValidUntil bound when the code can be used; zero means
+	// unbounded. ValidUntil is exclusive.
+	ValidFrom      time.Time
+	ValidUntil     time.Time
+	MaxUses        int32
+	MaxUsesPerUser int32
+	// Uses is how many PENDING and CONFIRMED bookings use the code.
+	Uses      int32
+	CreatedAt time.Time
+}
+
+// PromoRepo stores promo codes and which bookings used them.
+type PromoRepo interface {
+	// Create fails with PROMO_CODE_ALREADY_EXISTS if the code is taken.
+	Create(ctx context.Context, p *PromoCode) (*PromoCode, error)
+	// GetByCode returns the promo code with its current uses, or
+	// PROMO_CODE_NOT_FOUND.
+	GetByCode(ctx context.Context, code string) (*PromoCode, error)
+	// Redeem records that userID's booking used p. Under a lock on the code
+	// it first checks that neither the code's uses nor the user's would go
+	// over their limits, counting only PENDING and CONFIRMED bookings, and
+	// fails with PROMO_CODE_NOT_APPLICABLE if one would.
+	Redeem(ctx context.Context, p *PromoCode, bookingID, userID uint64) error
+}
+
+// normalizePromoCode makes codes case-insensitive.
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// promoNotApplicable is PROMO_CODE_NOT_APPLICABLE with reason in metadata.
+func promoNotApplicable(reason, format string, args ...interface{}) error {
+	return bookingv1.ErrorPromoCodeNotApplicable(format, args...).WithMetadata(map[string]string{"reason": reason})
+}
+
+// checkApplies returns why p cannot be used for a booking of eventID in
+// currency at now, if it cannot. Usage limits are checked by Redeem.
+func (p *PromoCode) checkApplies(eventID uint64, currency string, now time.Time) error {
+	if !p.ValidFrom.IsZero() && now.Before(p.ValidFrom) {
+		return promoNotApplicable("not_started", "promo code %s is valid from %s", p.Code, p.ValidFrom.Format(time.RFC3339))
+	}
+	if !p.ValidUntil.IsZero() && !now.Before(p.ValidUntil) {
+		return promoNotApplicable("expired", "promo code %s expired at %s", p.Code, p.ValidUntil.Format(time.RFC3339))
+	}
+	if len(p.EventIDs) > 0 {
+		found := false
+		for _, id := range p.EventIDs {
+			if id == eventID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return promoNotApplicable("wrong_event", "promo code %s is not valid for event %d", p.Code, eventID)
+		}
+	}
+	if p.Type == bookingv1.PromoDiscountType_AMOUNT_OFF && p.Currency != currency {
+		return promoNotApplicable("wrong_currency", "promo code %s is in %s, not %s", p.Code, p.Currency, currency)
+	}
+	return nil
+}
+
+// discount is what p takes off total, in minor units. A percentage rounds
+// down and a fixed amount never takes the total below zero.
+func (p *PromoCode) discount(total int64) int64 {
+	var off int64
+	switch p.Type {
+	case bookingv1.PromoDiscountType_PERCENT_OFF:
+		off = total * int64(p.PercentOff) / 100
+	case bookingv1.PromoDiscountType_AMOUNT_OFF:
+		off = p.AmountOff
+	}
+	if off > total {
+		off = total
+	}
+	return off
+}
+
+// applyPromo prices booking with the promo code it was requested with, if
+// any: the discount comes off its total and is recorded on it.
+func applyPromo(booking *bookingv1.Booking, promo *PromoCode) {
+	if promo == nil {
+		return
+	}
+	total := booking.GetTotalCost()
+	off := promo.discount(total.GetAmountMinor())
+	booking.PromoCode = promo.Code
+	booking.Discount = &moneyv1.Money{AmountMinor: off, Currency: total.GetCurrency()}
+	booking.TotalCost = &moneyv1.Money{AmountMinor: total.GetAmountMinor() - off, Currency: total.GetCurrency()}
+}
+
+// lookupPromo returns the promo code a booking of eventID in currency was
+// requested with, after checking it can be used for it; nil for no code.
+func (uc *BookingUsecase) lookupPromo(ctx context.Context, code string, eventID uint64, currency string) (*PromoCode, error) {
+	code = normalizePromoCode(code)
+	if code == "" {
+		return nil, nil
+	}
+	promo, err := uc.promos.GetByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	if err := promo.checkApplies(eventID, currency, time.Now()); err != nil {
+		return nil, err
+	}
+	return promo, nil
+}
+
+// PromoUsecase manages promo codes.
+type PromoUsecase struct {
+	repo PromoRepo
+	log  *log.Helper
+}
+
+func NewPromoUsecase(repo PromoRepo, logger log.Logger) *PromoUsecase {
+	return &PromoUsecase{repo: repo, log: log.NewHelper(logger)}
+}
+
+// CreatePromoCode checks p and stores it with its code upper-cased.
+func (uc *PromoUsecase) CreatePromoCode(ctx context.Context, p *PromoCode) (*PromoCode, error) {
+	p.Code = normalizePromoCode(p.Code)
+	if p.Code == "" || len(p.Code) > 32 {
+		return nil, fmt.Errorf("code must be 1 to 32 characters")
+	}
+	switch p.Type {
+	case bookingv1.PromoDiscountType_PERCENT_OFF:
+		if p.PercentOff < 1 || p.PercentOff > 100 {
+			return nil, fmt.Errorf("percent_off must be between 1 and 100")
+		}
+		p.AmountOff, p.Currency = 0, ""
+	case bookingv1.PromoDiscountType_AMOUNT_OFF:
+		if p.AmountOff <= 0 || p.Currency == "" {
+			return nil, fmt.Errorf("amount_off must be a positive amount with a currency")
+		}
+		p.PercentOff = 0
+	default:
+		return nil, fmt.Errorf("type must be PERCENT_OFF or AMOUNT_OFF")
+	}
+	if !p.ValidFrom.IsZero() && !p.ValidUntil.IsZero() && !p.ValidUntil.After(p.ValidFrom) {
+		return nil, fmt.Errorf("valid_until must be after valid_from")
+	}
+	if p.MaxUses < 0 || p.MaxUsesPerUser < 0 {
+		return nil, fmt.Errorf("usage limits cannot be negative")
+	}
+	created, err := uc.repo.Create(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Promo code %s created: type=%s, events=%v", created.Code, created.Type, created.EventIDs)
+	return created, nil
+}
+
+// GetPromoCode looks a code up regardless of its case.
+func (uc *PromoUsecase) GetPromoCode(ctx context.Context, code string) (*PromoCode, error) {
+	return uc.repo.GetByCode(ctx, normalizePromoCode(code))
+}
@@ -0,0 +1,46 @@
+package biz
+
+import (
+	"testing"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	moneyv1 "eventservice/api/money/v1"
+)
+
+func TestPromoDiscount(t *testing.T) {
+	tests := []struct {
+		name  string
+		promo PromoCode
+		total int64
+		want  int64
+	}{
+		{name: "percent", promo: PromoCode{Type: bookingv1.PromoDiscountType_PERCENT_OFF, PercentOff: 10}, total: 5000, want: 500},
+		{name: "percent rounds down", promo: PromoCode{Type: bookingv1.PromoDiscountType_PERCENT_OFF, PercentOff: 15}, total: 999, want: 149},
+		{name: "percent of a unit", promo: PromoCode{Type: bookingv1.PromoDiscountType_PERCENT_OFF, PercentOff: 50}, total: 1, want: 0},
+		{name: "whole price", promo: PromoCode{Type: bookingv1.PromoDiscountType_PERCENT_OFF, PercentOff: 100}, total: 999, want: 999},
+		{name: "amount", promo: PromoCode{Type: bookingv1.PromoDiscountType_AMOUNT_OFF, AmountOff: 250}, total: 1000, want: 250},
+		{name: "amount capped at total", promo: PromoCode{Type: bookingv1.PromoDiscountType_AMOUNT_OFF, AmountOff: 2500}, total: 1000, want: 1000},
+		{name: "free booking", promo: PromoCode{Type: bookingv1.PromoDiscountType_AMOUNT_OFF, AmountOff: 250}, total: 0, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.promo.discount(tt.total); got != tt.want {
+				t.Errorf("discount(%d) = %d, want %d", tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPromo(t *testing.T) {
+	booking := &bookingv1.Booking{TotalCost: &moneyv1.Money{AmountMinor: 999, Currency: "EUR"}}
+	applyPromo(booking, &PromoCode{Code: "SAVE15", Type: bookingv1.PromoDiscountType_PERCENT_OFF, PercentOff: 15})
+	if booking.PromoCode != "SAVE15" {
+		t.Errorf("PromoCode = %q, want SAVE15", booking.PromoCode)
+	}
+	if got := booking.GetDiscount(); got.GetAmountMinor() != 149 || got.GetCurrency() != "EUR" {
+		t.Errorf("Discount = %v, want 149 EUR", got)
+	}
+	if got := booking.GetTotalCost(); got.GetAmountMinor() != 850 || got.GetCurrency() != "EUR" {
+		t.Errorf("TotalCost = %v, want 850 EUR", got)
+	}
+}
@@ -0,0 +1,199 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	eventv1 "eventservice/api/eventservice/v1"
+)
+
+// Confirm saga states. COMPLETED and COMPENSATED are final.
+const (
+	SagaStarted           = "STARTED"            // booking checked, seats not yet taken from the event
+	SagaInventoryReserved = "INVENTORY_RESERVED" // seats taken from the event, booking still PENDING
+	SagaCompleted         = "COMPLETED"          // booking CONFIRMED
+	SagaCompensating      = "COMPENSATING"       // a step failed; giving the seats back
+	SagaCompensated       = "COMPENSATED"        // seats given back, booking left as it was
+)
+
+// sagaStaleAfter is how long a saga may sit in one state before recovery
+// assumes its process died and takes it over.
+const sagaStaleAfter = time.Minute
+
+// ConfirmSaga is the durable state of one attempt to confirm a booking.
+type ConfirmSaga struct {
+	ID        uint64
+	BookingID uint64
+	State     string
+	Error     string
+	UpdatedAt time.Time
+}
+
+// SagaRepo stores confirm sagas.
+type SagaRepo interface {
+	// Start records a new saga for the booking in SagaStarted. It fails if
+	// the booking already has an unfinished saga.
+	Start(ctx context.Context, bookingID uint64) (*ConfirmSaga, error)
+	// Advance moves the saga from state `from` to `to`, recording errMsg, only
+	// if it is still in `from`, and reports whether it did.
+	Advance(ctx context.Context, id uint64, from, to, errMsg string) (bool, error)
+	// ListUnfinished returns up to limit sagas that are not in a final state
+	// and were last updated before the cutoff, oldest first.
+	ListUnfinished(ctx context.Context, before time.Time, limit int) ([]*ConfirmSaga, error)
+	// ListInFlightEvents returns the events that have a booking with an
+	// unfinished saga.
+	ListInFlightEvents(ctx context.Context) ([]uint64, error)
+}
+
+// operationID is the event service operation for the saga's seat change. It
+// is stable across retries, so taking the seats is applied at most once and
+// can be reverted even if the first call's outcome is unknown.
+func (s *ConfirmSaga) operationID() string {
+	return fmt.Sprintf("booking-%d-confirm-%d", s.BookingID, s.ID)
+}
+
+// confirm runs the confirm saga for a PENDING booking:
+//
+//  1. take the seats from the event (DecrementSeats)
+//  2. mark the booking CONFIRMED, queue its outbox message, record the move
+//     in its history as made by `by` and issue its tickets
+//
+// If either step fails the seats are given back and the booking is left
+// PENDING. Each step is recorded before the next one starts, so that
+// RecoverSagas can finish or compensate a saga whose process died.
+func (uc *BookingUsecase) confirm(ctx context.Context, booking *bookingv1.Booking, by StatusActor) error {
+	saga, err := uc.sagas.Start(ctx, booking.Id)
+	if err != nil {
+		return err
+	}
+	return uc.runSaga(ctx, saga, booking, by)
+}
+
+// runSaga drives saga from its current state to a final one. It returns
+// the error that made it compensate, or an error if it could not finish.
+// A confirmation is recorded in the booking's history as made by `by`.
+func (uc *BookingUsecase) runSaga(ctx context.Context, saga *ConfirmSaga, booking *bookingv1.Booking, by StatusActor) error {
+	var failure error
+	if saga.Error != "" {
+		failure = fmt.Errorf("%s", saga.Error)
+	}
+	for {
+		switch saga.State {
+		case SagaStarted:
+			_, err := uc.eventClient.DecrementSeats(ctx, &eventv1.DecrementSeatsRequest{
+				EventId:     booking.EventId,
+				SeatIds:     booking.SeatIds,
+				OperationId: saga.operationID(),
+			})
+			if err != nil {
+				failure = fmt.Errorf("failed to reserve seats for booking %d: %w", booking.Id, err)
+				if err := uc.advanceSaga(ctx, saga, SagaCompensating, failure); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := uc.advanceSaga(ctx, saga, SagaInventoryReserved, nil); err != nil {
+				return err
+			}
+
+		case SagaInventoryReserved:
+			err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+				ok, err := uc.repo.UpdateStatus(ctx, booking.Id, bookingv1.BookingStatus_PENDING, bookingv1.BookingStatus_CONFIRMED)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return bookingv1.ErrorInvalidStatusTransition("booking %d is no longer %s", booking.Id, bookingv1.BookingStatus_PENDING)
+				}
+				if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: StatusTopic(bookingv1.BookingStatus_CONFIRMED), BookingID: booking.Id}); err != nil {
+					return err
+				}
+				if err := uc.recordStatus(ctx, booking.Id, bookingv1.BookingStatus_PENDING, bookingv1.BookingStatus_CONFIRMED, by); err != nil {
+					return err
+				}
+				if err := uc.issueTickets(ctx, booking.Id); err != nil {
+					return err
+				}
+				return uc.advanceSaga(ctx, saga, SagaCompleted, nil)
+			})
+			if err != nil {
+				saga.State = SagaInventoryReserved
+				failure = err
+				if err := uc.advanceSaga(ctx, saga, SagaCompensating, failure); err != nil {
+					return err
+				}
+			}
+
+		case SagaCompensating:
+			if _, err := uc.eventClient.RevertSeatAdjustment(ctx, &eventv1.RevertSeatAdjustmentRequest{
+				OperationId: saga.operationID(),
+			}); err != nil {
+				return fmt.Errorf("failed to give back seats for booking %d: %w", booking.Id, err)
+			}
+			if err := uc.advanceSaga(ctx, saga, SagaCompensated, failure); err != nil {
+				return err
+			}
+
+		case SagaCompleted:
+			return nil
+
+		case SagaCompensated:
+			if failure == nil {
+				failure = fmt.Errorf("confirming booking %d was rolled back", booking.Id)
+			}
+			return failure
+
+		default:
+			return fmt.Errorf("confirm saga %d is in unknown state %q", saga.ID, saga.State)
+		}
+	}
+}
+
+// advanceSaga moves saga to state `to`. It fails if someone else, such as
+// recovery on another replica, moved the saga first.
+func (uc *BookingUsecase) advanceSaga(ctx context.Context, saga *ConfirmSaga, to string, cause error) error {
+	errMsg := ""
+	if cause != nil {
+		errMsg = cause.Error()
+	}
+	ok, err := uc.sagas.Advance(ctx, saga.ID, saga.State, to, errMsg)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("confirm saga %d is no longer %s", saga.ID, saga.State)
+	}
+	uc.log.Infof("Confirm saga %d for booking %d: %s -> %s", saga.ID, saga.BookingID, saga.State, to)
+	saga.State = to
+	return nil
+}
+
+// RecoverSagas finishes or compensates confirm sagas whose process died
+// part-way through, and returns how many it brought to a final state.
+func (uc *BookingUsecase) RecoverSagas(ctx context.Context, limit int) (int, error) {
+	stale, err := uc.sagas.ListUnfinished(ctx, time.Now().Add(-sagaStaleAfter), limit)
+	if err != nil {
+		return 0, err
+	}
+	recovered := 0
+	for _, saga := range stale {
+		booking, err := uc.repo.Get(ctx, saga.BookingID)
+		if err != nil {
+			uc.log.Errorf("Failed to load booking %d for confirm saga %d: %v", saga.BookingID, saga.ID, err)
+			continue
+		}
+		by := BySystem("saga-recovery", fmt.Sprintf("finished confirm saga %d", saga.ID))
+		if err := uc.runSaga(ctx, saga, booking, by); err != nil && saga.State != SagaCompensated {
+			uc.log.Errorf("Failed to recover confirm saga %d: %v", saga.ID, err)
+			continue
+		}
+		if saga.State == SagaCompleted {
+			uc.releaseHolds(ctx, booking)
+		}
+		uc.log.Infof("Recovered confirm saga %d for booking %d: %s", saga.ID, saga.BookingID, saga.State)
+		recovered++
+	}
+	return recovered, nil
+}
@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+)
+
+// SeatUpdate is a change to the seats of one event.
+type SeatUpdate struct {
+	EventID   uint64
+	Kind      bookingv1.SeatUpdateKind
+	SeatIDs   []string
+	ExpiresAt time.Time
+	At        time.Time
+	Snapshot  bool
+}
+
+// SeatFeed carries the seat updates BookingRepo publishes as it holds,
+// releases, books and gives back seats.
+type SeatFeed interface {
+	// Subscribe returns the event's updates from now on. The channel is
+	// closed when ctx ends or the feed breaks.
+	Subscribe(ctx context.Context, eventID uint64) (<-chan *SeatUpdate, error)
+}
+
+// WatchSeats sends the event's booked and held seats, then every update
+// until ctx ends or send fails. It subscribes before reading the current
+// state, so no change falls between the two; a change may show up in both.
+func (uc *BookingUsecase) WatchSeats(ctx context.Context, eventID uint64, send func(*SeatUpdate) error) error {
+	updates, err := uc.seatFeed.Subscribe(ctx, eventID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	booked, err := uc.repo.ListBookedSeats(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if err := send(&SeatUpdate{EventID: eventID, Kind: bookingv1.SeatUpdateKind_SEAT_BOOKED, SeatIDs: booked, At: now, Snapshot: true}); err != nil {
+		return err
+	}
+	locked, err := uc.repo.GetLockedSeats(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	// One update per hold expiry, soonest first
+	byExpiry := make(map[time.Time][]string)
+	for _, l := range locked {
+		byExpiry[l.ExpiresAt] = append(byExpiry[l.ExpiresAt], l.SeatID)
+	}
+	expiries := make([]time.Time, 0, len(byExpiry))
+	for at := range byExpiry {
+		expiries = append(expiries, at)
+	}
+	sort.Slice(expiries, func(i, j int) bool { return expiries[i].Before(expiries[j]) })
+	for _, at := range expiries {
+		if err := send(&SeatUpdate{EventID: eventID, Kind: bookingv1.SeatUpdateKind_SEAT_HELD, SeatIDs: byExpiry[at], ExpiresAt: at, At: now, Snapshot: true}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case u, ok := <-updates:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return fmt.Errorf("seat feed of event %d closed", eventID)
+			}
+			if err := send(u); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+	eventv1 "eventservice/api/eventservice/v1"
+)
+
+// SeatLimitPolicy caps the seats one user may have of an event that sets no
+// limit of its own.
+type SeatLimitPolicy struct {
+	DefaultPerUser int32
+}
+
+// ProvideSeatLimitPolicy reads the default per-user seat limit from config,
+// falling back to 10 seats.
+func ProvideSeatLimitPolicy(c *conf.Data) *SeatLimitPolicy {
+	p := &SeatLimitPolicy{DefaultPerUser: 10}
+	if l := c.GetSeatLimits(); l != nil && l.DefaultPerUser > 0 {
+		p.DefaultPerUser = l.DefaultPerUser
+	}
+	return p
+}
+
+// seatLimit is how many seats of ev one user may have.
+func (uc *BookingUsecase) seatLimit(ev *eventv1.ShowEvent) int32 {
+	if ev.MaxSeatsPerUser > 0 {
+		return ev.MaxSeatsPerUser
+	}
+	return uc.seatLimits.DefaultPerUser
+}
+
+// userSeats returns the distinct seats of the event that userID holds or
+// has on PENDING and CONFIRMED bookings. A PENDING booking's seats are
+// usually held as well, so they are only counted once.
+func (uc *BookingUsecase) userSeats(ctx context.Context, eventID, userID uint64) (map[string]bool, error) {
+	booked, err := uc.repo.ListUserSeats(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := uc.repo.GetLockedSeats(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	seats := make(map[string]bool, len(booked)+len(locked))
+	for _, s := range booked {
+		seats[s] = true
+	}
+	for _, l := range locked {
+		if l.Owner.UserID == userID {
+			seats[l.SeatID] = true
+		}
+	}
+	return seats, nil
+}
+
+// checkSeatLimit fails with SEAT_LIMIT_EXCEEDED if userID, having seats,
+// would have more than limit seats after taking seatIDs and giving up
+// releasing. It returns the seats of seatIDs the user does not have yet.
+func checkSeatLimit(userID uint64, limit int32, seats map[string]bool, seatIDs, releasing []string) ([]string, error) {
+	drop := make(map[string]bool, len(releasing))
+	for _, s := range releasing {
+		drop[s] = true
+	}
+	current := 0
+	for s := range seats {
+		if !drop[s] {
+			current++
+		}
+	}
+	var taking []string
+	for _, s := range seatIDs {
+		if !seats[s] {
+			taking = append(taking, s)
+		}
+	}
+	if current+len(taking) > int(limit) {
+		return nil, bookingv1.ErrorSeatLimitExceeded("user %d may have at most %d seats of this event and already has %d", userID, limit, current).
+			WithMetadata(map[string]string{
+				"limit":     strconv.Itoa(int(limit)),
+				"current":   strconv.Itoa(current),
+				"requested": strconv.Itoa(len(taking)),
+			})
+	}
+	return taking, nil
+}
+
+// holdSeats holds seatIDs of ev for owner, keeping the owner within the
+// event's per-user seat limit; seats in releasing, which the caller is
+// about to give up, do not count. The limit is checked again once the hold
+// is taken, so concurrent holds cannot together go over it: whichever finds
+// itself over gives its new seats back and fails.
+func (uc *BookingUsecase) holdSeats(ctx context.Context, ev *eventv1.ShowEvent, seatIDs []string, owner SeatHold, releasing []string) (*HoldResult, error) {
+	if len(seatIDs) == 0 {
+		return nil, fmt.Errorf("no seats requested")
+	}
+	limit := uc.seatLimit(ev)
+	seats, err := uc.userSeats(ctx, ev.Id, owner.UserID)
+	if err != nil {
+		return nil, err
+	}
+	taking, err := checkSeatLimit(owner.UserID, limit, seats, seatIDs, releasing)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := uc.repo.HoldSeats(ctx, ev.Id, seatIDs, owner, *uc.holdPolicy)
+	if err != nil || len(res.Conflicts) > 0 || len(taking) == 0 {
+		return res, err
+	}
+
+	after, err := uc.userSeats(ctx, ev.Id, owner.UserID)
+	if err == nil {
+		for _, s := range taking {
+			delete(after, s)
+		}
+		_, err = checkSeatLimit(owner.UserID, limit, after, taking, releasing)
+	}
+	if err != nil {
+		if _, rlErr := uc.repo.ReleaseSeats(ctx, ev.Id, taking, SeatHold{UserID: owner.UserID, Token: res.Token}); rlErr != nil {
+			uc.log.Errorf("Failed to release seats %v of event %d over user %d's limit: %v", taking, ev.Id, owner.UserID, rlErr)
+		}
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	eventv1 "eventservice/api/eventservice/v1"
+)
+
+// bookingTransitions lists the statuses each status may move to. CANCELLED,
+// EXPIRED and REFUNDED are final.
+var bookingTransitions = map[bookingv1.BookingStatus][]bookingv1.BookingStatus{
+	bookingv1.BookingStatus_PENDING: {
+		bookingv1.BookingStatus_CONFIRMED,
+		bookingv1.BookingStatus_CANCELLED,
+		bookingv1.BookingStatus_EXPIRED,
+	},
+	bookingv1.BookingStatus_CONFIRMED: {
+		bookingv1.BookingStatus_CANCELLED,
+		bookingv1.BookingStatus_REFUNDED,
+	},
+}
+
+// CanTransition reports whether a booking in status from may move to status to.
+func CanTransition(from, to bookingv1.BookingStatus) bool {
+	for _, next := range bookingTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// UpdateStatus moves a booking to status `to` and applies the inventory
+// change the move implies: confirming takes the seats from the event (see
+// confirm), and cancelling or refunding a confirmed booking gives them back.
+// Every status change goes through here, and is recorded in the booking's
+// history as made by `by`.
+func (uc *BookingUsecase) UpdateStatus(ctx context.Context, id uint64, to bookingv1.BookingStatus, by StatusActor) (*bookingv1.Booking, error) {
+	booking, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	from := booking.Status
+	if !CanTransition(from, to) {
+		return nil, bookingv1.ErrorInvalidStatusTransition("booking %d cannot move from %s to %s", id, from, to)
+	}
+
+	if to == bookingv1.BookingStatus_CONFIRMED {
+		if err := uc.confirm(ctx, booking, by); err != nil {
+			return nil, err
+		}
+	} else if err := uc.moveStatus(ctx, booking, to, by); err != nil {
+		return nil, err
+	}
+
+	if from == bookingv1.BookingStatus_PENDING {
+		uc.releaseHolds(ctx, booking)
+	}
+	if from == bookingv1.BookingStatus_CONFIRMED {
+		// The seats went back to the event; waitlisted users get them first
+		if _, err := uc.offerWaitlist(ctx, booking.EventId); err != nil {
+			uc.log.Errorf("Failed to offer seats of event %d: %v", booking.EventId, err)
+		}
+	}
+
+	uc.log.Infof("Booking %d moved from %s to %s", id, from, to)
+	booking.Status = to
+	return booking, nil
+}
+
+// moveStatus claims the transition, records it in the outbox and the
+// booking's history and voids a confirmed booking's tickets and gives its
+// seats back in one transaction, so that two concurrent requests cannot both
+// apply it and a failed inventory call leaves the booking as it was. If the
+// commit fails after the seats were given back, that adjustment is reverted.
+func (uc *BookingUsecase) moveStatus(ctx context.Context, booking *bookingv1.Booking, to bookingv1.BookingStatus, by StatusActor) error {
+	from := booking.Status
+	operationID := ""
+	err := uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.repo.UpdateStatus(ctx, booking.Id, from, to)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorInvalidStatusTransition("booking %d is no longer %s", booking.Id, from)
+		}
+		if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: StatusTopic(to), BookingID: booking.Id}); err != nil {
+			return err
+		}
+		if err := uc.recordStatus(ctx, booking.Id, from, to, by); err != nil {
+			return err
+		}
+		if from != bookingv1.BookingStatus_CONFIRMED {
+			return nil
+		}
+		if err := uc.tickets.Void(ctx, booking.Id, nil); err != nil {
+			return err
+		}
+		if len(booking.SeatIds) == 0 {
+			return nil
+		}
+		op := fmt.Sprintf("booking-%d-%s", booking.Id, strings.ToLower(to.String()))
+		if _, err := uc.eventClient.IncrementSeats(ctx, &eventv1.IncrementSeatsRequest{
+			EventId:     booking.EventId,
+			SeatIds:     booking.SeatIds,
+			OperationId: op,
+		}); err != nil {
+			return err
+		}
+		operationID = op
+		return nil
+	})
+	if err != nil && operationID != "" {
+		if _, rbErr := uc.eventClient.RevertSeatAdjustment(ctx, &eventv1.RevertSeatAdjustmentRequest{OperationId: operationID}); rbErr != nil {
+			uc.log.Errorf("Failed to revert inventory for booking %d: %v", booking.Id, rbErr)
+		}
+	}
+	return err
+}
+
+// releaseHolds drops the seat holds a booking took while it was PENDING.
+// Holds that already expired, or were taken by someone else since, are
+// left alone, as are those of bookings made before their hold token was
+// recorded; those expire on their own.
+func (uc *BookingUsecase) releaseHolds(ctx context.Context, booking *bookingv1.Booking) {
+	if len(booking.SeatIds) == 0 {
+		return
+	}
+	token, err := uc.repo.GetHoldToken(ctx, booking.Id)
+	if err != nil {
+		uc.log.Errorf("Failed to load the hold token of booking %d: %v", booking.Id, err)
+		return
+	}
+	if token == "" {
+		return
+	}
+	owner := SeatHold{UserID: booking.UserId, Token: token}
+	notOwned, err := uc.repo.ReleaseSeats(ctx, booking.EventId, booking.SeatIds, owner)
+	if err != nil {
+		uc.log.Errorf("Failed to release holds for booking %d: %v", booking.Id, err)
+		return
+	}
+	if len(notOwned) > 0 {
+		uc.log.Infof("Booking %d: seats %v are no longer held by user %d", booking.Id, notOwned, booking.UserId)
+	}
+}
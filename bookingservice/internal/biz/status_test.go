@@ -0,0 +1,48 @@
+package biz
+
+import (
+	"testing"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+)
+
+func TestCanTransition(t *testing.T) {
+	var (
+		pending   = bookingv1.BookingStatus_PENDING
+		confirmed = bookingv1.BookingStatus_CONFIRMED
+		cancelled = bookingv1.BookingStatus_CANCELLED
+		expired   = bookingv1.BookingStatus_EXPIRED
+		refunded  = bookingv1.BookingStatus_REFUNDED
+		none      = bookingv1.BookingStatus_BOOKING_STATUS_UNSPECIFIED
+	)
+	tests := []struct {
+		from, to bookingv1.BookingStatus
+		want     bool
+	}{
+		{pending, confirmed, true},
+		{pending, cancelled, true},
+		{pending, expired, true},
+		{pending, refunded, false},
+		{pending, pending, false},
+		{confirmed, cancelled, true},
+		{confirmed, refunded, true},
+		{confirmed, expired, false},
+		{confirmed, pending, false},
+		{confirmed, confirmed, false},
+		{cancelled, confirmed, false},
+		{cancelled, pending, false},
+		{expired, confirmed, false},
+		{expired, cancelled, false},
+		{refunded, confirmed, false},
+		{refunded, cancelled, false},
+		{none, pending, false},
+		{pending, none, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"_to_"+tt.to.String(), func(t *testing.T) {
+			if got := CanTransition(tt.from, tt.to); got != tt.want {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+)
+
+// ErrInvalidTicketPayload is returned for a ticket payload that is
+// malformed, signed with an unknown key, or has a bad signature.
+var ErrInvalidTicketPayload = errors.New("invalid ticket payload")
+
+// Ticket admits one seat of a CONFIRMED booking.
+type Ticket struct {
+	ID        uint64
+	BookingID uint64
+	EventID   uint64
+	SeatID    string
+	Code      string
+	Status    bookingv1.TicketStatus
+	IssuedAt  time.Time
+	VoidedAt  time.Time
+	// CheckedInAt and CheckedInGate record the scan that admitted the
+	// ticket; CheckedInAt is zero until then.
+	CheckedInAt   time.Time
+	CheckedInGate string
+	// Payload and KeyID are the signed QR payload of a VALID ticket and the
+	// key that signed it. They are filled in by ListTickets, not stored.
+	Payload string
+	KeyID   string
+}
+
+// TicketRepo stores seat tickets. A seat has at most one VALID ticket per
+// booking.
+type TicketRepo interface {
+	Create(ctx context.Context, tickets []*Ticket) error
+	// ListByBooking returns the booking's tickets in issue order, voided
+	// ones only if includeVoid is set.
+	ListByBooking(ctx context.Context, bookingID uint64, includeVoid bool) ([]*Ticket, error)
+	// Void marks the booking's VALID tickets for seatIDs VOID; nil seatIDs
+	// voids all of them.
+	Void(ctx context.Context, bookingID uint64, seatIDs []string) error
+	// GetByCode returns the ticket with code, or ErrorTicketNotFound.
+	GetByCode(ctx context.Context, code string) (*Ticket, error)
+	// CheckIn marks a VALID ticket that was not checked in yet as admitted
+	// at gate, and reports whether it did.
+	CheckIn(ctx context.Context, id uint64, gate string, at time.Time) (bool, error)
+	// Attendance counts the event's VALID and admitted tickets.
+	Attendance(ctx context.Context, eventID uint64) (*Attendance, error)
+}
+
+// TicketClaims is what a ticket payload vouches for.
+type TicketClaims struct {
+	TicketID  uint64 `json:"t"`
+	BookingID uint64 `json:"b"`
+	EventID   uint64 `json:"e"`
+	SeatID    string `json:"s"`
+	Code      string `json:"c"`
+}
+
+// TicketSigner signs ticket payloads with the active key of a key ring and
+// verifies them with any key in it. Rotating means adding a new key, making
+// it active and, once every scanner has it, dropping the old one; payloads
+// are signed when tickets are listed, so they move to the new key by
+// themselves.
+type TicketSigner struct {
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+// minSigningKeyLen is the shortest HMAC key accepted from config, so a
+// placeholder left in place stops the service instead of signing with it.
+const minSigningKeyLen = 32
+
+// ProvideTicketSigner builds the signer from the ticket keys in config.
+// Every secret must be at least minSigningKeyLen bytes.
+func ProvideTicketSigner(c *conf.Data) (*TicketSigner, error) {
+	t := c.GetTickets()
+	s := &TicketSigner{activeKeyID: t.GetActiveKeyId(), keys: make(map[string][]byte)}
+	for _, k := range t.GetKeys() {
+		if k.Id == "" || strings.Contains(k.Id, ".") || k.Secret == "" {
+			return nil, fmt.Errorf("ticket key %q needs an id without dots and a secret", k.Id)
+		}
+		if len(k.Secret) < minSigningKeyLen {
+			return nil, fmt.Errorf("ticket key %q must be at least %d bytes", k.Id, minSigningKeyLen)
+		}
+		s.keys[k.Id] = []byte(k.Secret)
+	}
+	if _, ok := s.keys[s.activeKeyID]; !ok {
+		return nil, fmt.Errorf("active ticket key %q is not configured", s.activeKeyID)
+	}
+	return s, nil
+}
+
+// ActiveKeyID is the id of the key new payloads are signed with.
+func (s *TicketSigner) ActiveKeyID() string {
+	return s.activeKeyID
+}
+
+// Sign returns the payload for claims, signed with the active key.
+func (s *TicketSigner) Sign(claims TicketClaims) (string, error) {
+	body, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := s.activeKeyID + "." + base64.RawURLEncoding.EncodeToString(body)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(ticketMAC(s.keys[s.activeKeyID], signed)), nil
+}
+
+// Verify checks payload's signature and returns its claims. It needs
+// nothing but the key ring, so scanners can run it offline.
+func (s *TicketSigner) Verify(payload string) (*TicketClaims, error) {
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidTicketPayload
+	}
+	key, ok := s.keys[parts[0]]
+	if !ok {
+		return nil, ErrInvalidTicketPayload
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(sig, ticketMAC(key, parts[0]+"."+parts[1])) {
+		return nil, ErrInvalidTicketPayload
+	}
+	body, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidTicketPayload
+	}
+	var claims TicketClaims
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return nil, ErrInvalidTicketPayload
+	}
+	return &claims, nil
+}
+
+func ticketMAC(key []byte, signed string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// ListTickets returns the tickets of userID's booking, each VALID one with
+// a freshly signed payload. A CONFIRMED booking from before tickets existed
+// gets its tickets issued here.
+func (uc *BookingUsecase) ListTickets(ctx context.Context, bookingID, userID uint64, includeVoid bool) ([]*Ticket, error) {
+	booking, err := uc.repo.Get(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != userID {
+		return nil, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", bookingID, userID)
+	}
+	if booking.Status == bookingv1.BookingStatus_CONFIRMED {
+		if err := uc.issueTickets(ctx, bookingID); err != nil {
+			return nil, err
+		}
+	}
+	tickets, err := uc.tickets.ListByBooking(ctx, bookingID, includeVoid)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tickets {
+		if t.Status != bookingv1.TicketStatus_TICKET_VALID {
+			continue
+		}
+		payload, err := uc.signer.Sign(TicketClaims{
+			TicketID:  t.ID,
+			BookingID: t.BookingID,
+			EventID:   t.EventID,
+			SeatID:    t.SeatID,
+			Code:      t.Code,
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.Payload, t.KeyID = payload, uc.signer.ActiveKeyID()
+	}
+	return tickets, nil
+}
+
+// issueTickets gives every seat of the booking that lacks a VALID ticket a
+// new one. It runs in the transaction that confirms the booking or changes
+// its seats or owner.
+func (uc *BookingUsecase) issueTickets(ctx context.Context, bookingID uint64) error {
+	booking, err := uc.repo.Get(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+	valid, err := uc.tickets.ListByBooking(ctx, bookingID, false)
+	if err != nil {
+		return err
+	}
+	ticketed := make(map[string]bool, len(valid))
+	for _, t := range valid {
+		ticketed[t.SeatID] = true
+	}
+	var tickets []*Ticket
+	for _, seatID := range booking.SeatIds {
+		if ticketed[seatID] {
+			continue
+		}
+		code, err := newTicketCode()
+		if err != nil {
+			return err
+		}
+		tickets = append(tickets, &Ticket{
+			BookingID: bookingID,
+			EventID:   booking.EventId,
+			SeatID:    seatID,
+			Code:      code,
+			Status:    bookingv1.TicketStatus_TICKET_VALID,
+		})
+	}
+	if len(tickets) == 0 {
+		return nil
+	}
+	return uc.tickets.Create(ctx, tickets)
+}
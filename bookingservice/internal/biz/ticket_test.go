@@ -0,0 +1,109 @@
+package biz
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"bookingservice/internal/conf"
+)
+
+const (
+	testKey1 = "0123456789abcdef0123456789abcdef"
+	testKey2 = "fedcba9876543210fedcba9876543210"
+)
+
+func newTestTicketSigner(t *testing.T, active string, keys ...*conf.Data_Tickets_Key) *TicketSigner {
+	t.Helper()
+	s, err := ProvideTicketSigner(&conf.Data{Tickets: &conf.Data_Tickets{ActiveKeyId: active, Keys: keys}})
+	if err != nil {
+		t.Fatalf("ProvideTicketSigner: %v", err)
+	}
+	return s
+}
+
+func TestProvideTicketSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		active  string
+		keys    []*conf.Data_Tickets_Key
+		wantErr string
+	}{
+		{name: "one key", active: "k1", keys: []*conf.Data_Tickets_Key{{Id: "k1", Secret: testKey1}}},
+		{name: "rotation ring", active: "k2", keys: []*conf.Data_Tickets_Key{{Id: "k1", Secret: testKey1}, {Id: "k2", Secret: testKey2}}},
+		{name: "no keys", active: "k1", wantErr: "not configured"},
+		{name: "active key missing", active: "k2", keys: []*conf.Data_Tickets_Key{{Id: "k1", Secret: testKey1}}, wantErr: "not configured"},
+		{name: "empty secret", active: "k1", keys: []*conf.Data_Tickets_Key{{Id: "k1"}}, wantErr: "needs an id"},
+		{name: "dot in id", active: "k.1", keys: []*conf.Data_Tickets_Key{{Id: "k.1", Secret: testKey1}}, wantErr: "needs an id"},
+		{name: "short secret", active: "k1", keys: []*conf.Data_Tickets_Key{{Id: "k1", Secret: "my_ticket_key"}}, wantErr: "at least 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProvideTicketSigner(&conf.Data{Tickets: &conf.Data_Tickets{ActiveKeyId: tt.active, Keys: tt.keys}})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTicketSignerVerify(t *testing.T) {
+	k1 := &conf.Data_Tickets_Key{Id: "k1", Secret: testKey1}
+	k2 := &conf.Data_Tickets_Key{Id: "k2", Secret: testKey2}
+	claims := TicketClaims{TicketID: 7, BookingID: 3, EventID: 1, SeatID: "A1", Code: "ABC123"}
+	old, err := newTestTicketSigner(t, "k1", k1).Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	parts := strings.Split(old, ".")
+
+	tests := []struct {
+		name    string
+		signer  *TicketSigner
+		payload string
+		wantErr bool
+	}{
+		{name: "same key", signer: newTestTicketSigner(t, "k1", k1), payload: old},
+		{name: "old key after rotation", signer: newTestTicketSigner(t, "k2", k1, k2), payload: old},
+		{name: "old key dropped", signer: newTestTicketSigner(t, "k2", k2), payload: old, wantErr: true},
+		{name: "same id, other secret", signer: newTestTicketSigner(t, "k1", &conf.Data_Tickets_Key{Id: "k1", Secret: testKey2}), payload: old, wantErr: true},
+		{name: "claims changed", signer: newTestTicketSigner(t, "k1", k1), payload: parts[0] + "." + parts[1] + "x." + parts[2], wantErr: true},
+		{name: "key id changed", signer: newTestTicketSigner(t, "k2", k1, k2), payload: "k2." + parts[1] + "." + parts[2], wantErr: true},
+		{name: "signature missing", signer: newTestTicketSigner(t, "k1", k1), payload: parts[0] + "." + parts[1], wantErr: true},
+		{name: "empty", signer: newTestTicketSigner(t, "k1", k1), payload: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.signer.Verify(tt.payload)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTicketPayload) {
+					t.Fatalf("err = %v, want ErrInvalidTicketPayload", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if *got != claims {
+				t.Errorf("claims = %+v, want %+v", *got, claims)
+			}
+		})
+	}
+}
+
+func TestTicketSignerSignsWithActiveKey(t *testing.T) {
+	s := newTestTicketSigner(t, "k2", &conf.Data_Tickets_Key{Id: "k1", Secret: testKey1}, &conf.Data_Tickets_Key{Id: "k2", Secret: testKey2})
+	payload, err := s.Sign(TicketClaims{TicketID: 1})
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if !strings.HasPrefix(payload, s.ActiveKeyID()+".") {
+		t.Errorf("payload %q is not signed with active key %q", payload, s.ActiveKeyID())
+	}
+}
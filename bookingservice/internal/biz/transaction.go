@@ -0,0 +1,9 @@
+package biz
+
+import "context"
+
+// Transaction runs fn inside one database transaction. Repository calls made
+// with the context passed to fn join that transaction.
+type Transaction interface {
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
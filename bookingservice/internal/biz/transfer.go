@@ -0,0 +1,273 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	userv1 "userservice/api/userservice/v1"
+)
+
+// Outbox topics for booking transfers.
+const (
+	TopicTransferStarted  = "booking.transfer_started"
+	TopicTransferAccepted = "booking.transferred"
+)
+
+// transferTTL is how long a recipient has to accept a transfer.
+const transferTTL = 72 * time.Hour
+
+// Transfer hands a booking from one user to another. Transfers are never
+// deleted, so they double as the booking's ownership history.
+type Transfer struct {
+	ID             uint64
+	BookingID      uint64
+	FromUserID     uint64
+	ToUserID       uint64
+	RecipientEmail string
+	State          bookingv1.TransferState
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+	ResolvedAt     time.Time
+	ResolvedBy     uint64
+}
+
+// TransferRepo stores booking transfers.
+type TransferRepo interface {
+	// Create saves a PENDING transfer. It fails with
+	// ErrorTransferAlreadyPending if the booking already has one.
+	Create(ctx context.Context, t *Transfer) (*Transfer, error)
+	Get(ctx context.Context, id uint64) (*Transfer, error)
+	// Resolve moves a PENDING transfer to state `to`, recording who did it,
+	// and reports whether it was still PENDING.
+	Resolve(ctx context.Context, id uint64, to bookingv1.TransferState, by uint64) (bool, error)
+	// ListByBooking returns the booking's transfers, oldest first.
+	ListByBooking(ctx context.Context, bookingID uint64) ([]*Transfer, error)
+}
+
+// newTicketCode returns a random code for a booking's ticket.
+func newTicketCode() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// TransferBooking starts handing a CONFIRMED booking from its owner to the
+// registered user with recipientEmail. The recipient is notified and has
+// transferTTL to accept.
+func (uc *BookingUsecase) TransferBooking(ctx context.Context, id, userID uint64, recipientEmail string) (*Transfer, error) {
+	recipientEmail = strings.TrimSpace(recipientEmail)
+	if recipientEmail == "" {
+		return nil, fmt.Errorf("recipient_email is required")
+	}
+	booking, err := uc.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != userID {
+		return nil, bookingv1.ErrorTransferNotAllowed("booking %d does not belong to user %d", id, userID)
+	}
+	if booking.Status != bookingv1.BookingStatus_CONFIRMED {
+		return nil, bookingv1.ErrorInvalidStatusTransition("only CONFIRMED bookings can be transferred; booking %d is %s", id, booking.Status)
+	}
+	recipient, err := uc.userClient.GetUserByEmail(ctx, &userv1.GetUserByEmailRequest{Email: recipientEmail})
+	if err != nil || recipient.Id == 0 {
+		return nil, bookingv1.ErrorRecipientNotFound("no user with email %s", recipientEmail)
+	}
+	if recipient.Id == userID {
+		return nil, fmt.Errorf("cannot transfer a booking to its owner")
+	}
+	if err := uc.expireLapsedTransfers(ctx, id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var transfer *Transfer
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = uc.transfers.Create(ctx, &Transfer{
+			BookingID:      id,
+			FromUserID:     userID,
+			ToUserID:       recipient.Id,
+			RecipientEmail: recipient.Email,
+			State:          bookingv1.TransferState_TRANSFER_PENDING,
+			CreatedAt:      now,
+			ExpiresAt:      now.Add(transferTTL),
+		})
+		if err != nil {
+			return err
+		}
+		return uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicTransferStarted, BookingID: id, TransferID: transfer.ID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Transfer %d started: booking_id=%d, from_user_id=%d, to_user_id=%d", transfer.ID, id, userID, recipient.Id)
+	return transfer, nil
+}
+
+// GetTransfer returns a transfer to its sender, its recipient or the
+// booking's current owner.
+func (uc *BookingUsecase) GetTransfer(ctx context.Context, id, userID uint64) (*Transfer, error) {
+	transfer, err := uc.transfers.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.FromUserID == userID || transfer.ToUserID == userID {
+		return transfer, nil
+	}
+	booking, err := uc.repo.Get(ctx, transfer.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId != userID {
+		return nil, bookingv1.ErrorTransferNotAllowed("user %d is not a party to transfer %d", userID, id)
+	}
+	return transfer, nil
+}
+
+// ListBookingTransfers returns every transfer of a booking to its current
+// owner, and to anyone else only the transfers they sent or received.
+func (uc *BookingUsecase) ListBookingTransfers(ctx context.Context, bookingID, userID uint64) ([]*Transfer, error) {
+	booking, err := uc.repo.Get(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := uc.transfers.ListByBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserId == userID {
+		return transfers, nil
+	}
+	var own []*Transfer
+	for _, t := range transfers {
+		if t.FromUserID == userID || t.ToUserID == userID {
+			own = append(own, t)
+		}
+	}
+	if len(own) == 0 {
+		return nil, bookingv1.ErrorBookingNotOwned("booking %d does not belong to user %d", bookingID, userID)
+	}
+	return own, nil
+}
+
+// AcceptTransfer gives the booking to the recipient. Ownership moves and
+// the booking gets a new ticket code and new seat tickets in the
+// transaction that accepts the transfer, so the sender's tickets stop
+// working at the same moment; both
+// parties are then notified through the outbox.
+func (uc *BookingUsecase) AcceptTransfer(ctx context.Context, id, userID uint64) (*Transfer, *bookingv1.Booking, error) {
+	transfer, err := uc.pendingTransfer(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if transfer.ToUserID != userID {
+		return nil, nil, bookingv1.ErrorTransferNotAllowed("transfer %d is not addressed to user %d", id, userID)
+	}
+	code, err := newTicketCode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.transfers.Resolve(ctx, id, bookingv1.TransferState_TRANSFER_ACCEPTED, userID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorTransferUnavailable("transfer %d is no longer pending", id)
+		}
+		ok, err = uc.repo.ChangeOwner(ctx, transfer.BookingID, transfer.FromUserID, transfer.ToUserID, code)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorInvalidStatusTransition("booking %d is no longer a CONFIRMED booking of user %d", transfer.BookingID, transfer.FromUserID)
+		}
+		if err := uc.tickets.Void(ctx, transfer.BookingID, nil); err != nil {
+			return err
+		}
+		if err := uc.issueTickets(ctx, transfer.BookingID); err != nil {
+			return err
+		}
+		return uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicTransferAccepted, BookingID: transfer.BookingID, TransferID: id})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	uc.log.Infof("Transfer %d accepted: booking %d now belongs to user %d", id, transfer.BookingID, userID)
+
+	if transfer, err = uc.transfers.Get(ctx, id); err != nil {
+		return nil, nil, err
+	}
+	booking, err := uc.repo.Get(ctx, transfer.BookingID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfer, booking, nil
+}
+
+// CancelTransfer lets the sender withdraw, or the recipient decline, a
+// pending transfer.
+func (uc *BookingUsecase) CancelTransfer(ctx context.Context, id, userID uint64) (*Transfer, error) {
+	transfer, err := uc.pendingTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.FromUserID != userID && transfer.ToUserID != userID {
+		return nil, bookingv1.ErrorTransferNotAllowed("user %d is not a party to transfer %d", userID, id)
+	}
+	ok, err := uc.transfers.Resolve(ctx, id, bookingv1.TransferState_TRANSFER_CANCELLED, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, bookingv1.ErrorTransferUnavailable("transfer %d is no longer pending", id)
+	}
+	uc.log.Infof("Transfer %d cancelled by user %d", id, userID)
+	return uc.transfers.Get(ctx, id)
+}
+
+// pendingTransfer returns a transfer that can still be accepted or
+// cancelled, marking it EXPIRED if it has lapsed.
+func (uc *BookingUsecase) pendingTransfer(ctx context.Context, id uint64) (*Transfer, error) {
+	transfer, err := uc.transfers.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.State != bookingv1.TransferState_TRANSFER_PENDING {
+		return nil, bookingv1.ErrorTransferUnavailable("transfer %d is %s", id, transfer.State)
+	}
+	if time.Now().After(transfer.ExpiresAt) {
+		if _, err := uc.transfers.Resolve(ctx, id, bookingv1.TransferState_TRANSFER_EXPIRED, 0); err != nil {
+			return nil, err
+		}
+		return nil, bookingv1.ErrorTransferUnavailable("transfer %d expired at %s", id, transfer.ExpiresAt.Format(time.RFC3339))
+	}
+	return transfer, nil
+}
+
+// expireLapsedTransfers marks the booking's lapsed PENDING transfers
+// EXPIRED, so that a new transfer can be started.
+func (uc *BookingUsecase) expireLapsedTransfers(ctx context.Context, bookingID uint64) error {
+	transfers, err := uc.transfers.ListByBooking(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, t := range transfers {
+		if t.State == bookingv1.TransferState_TRANSFER_PENDING && now.After(t.ExpiresAt) {
+			if _, err := uc.transfers.Resolve(ctx, t.ID, bookingv1.TransferState_TRANSFER_EXPIRED, 0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
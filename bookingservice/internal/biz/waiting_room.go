@@ -0,0 +1,300 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+	eventv1 "eventservice/api/eventservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// QueueEntry is a user's ticket in an event's queue. Users are let in in
+// order of Number: everyone with a number up to Serving is admitted.
+type QueueEntry struct {
+	Number  int64
+	Serving int64
+	// AdmittedUntil is when the user's admission lapses; zero until the
+	// user is first told they are in.
+	AdmittedUntil time.Time
+}
+
+// WaitingRoomRepo keeps the queues of events with a waiting room.
+type WaitingRoomRepo interface {
+	// Join gives userID the next number in the event's queue. A user who is
+	// already queued, or admitted and not yet lapsed, keeps their number.
+	Join(ctx context.Context, eventID, userID uint64, now time.Time) (*QueueEntry, error)
+	// Get returns userID's entry, or nil if they are not queued.
+	Get(ctx context.Context, eventID, userID uint64) (*QueueEntry, error)
+	// Admit records that userID's admission lapses at until, unless one was
+	// recorded already, and returns the recorded time.
+	Admit(ctx context.Context, eventID, userID uint64, until time.Time) (time.Time, error)
+	// Advance lets up to n more users of the event in and returns how many
+	// it did. Queues nobody has joined for a while are forgotten.
+	Advance(ctx context.Context, eventID uint64, n int64) (int64, error)
+	// ListQueues returns the events with a queue.
+	ListQueues(ctx context.Context) ([]uint64, error)
+}
+
+// WaitingRoomPolicy controls how fast visitors are let in.
+type WaitingRoomPolicy struct {
+	Interval         time.Duration
+	AdmitPerInterval int64
+	AdmissionTTL     time.Duration
+}
+
+// ProvideWaitingRoomPolicy reads the waiting room policy from config,
+// falling back to letting 50 visitors of each event in every second, each
+// for 10 minutes.
+func ProvideWaitingRoomPolicy(c *conf.Data) *WaitingRoomPolicy {
+	p := &WaitingRoomPolicy{
+		Interval:         time.Second,
+		AdmitPerInterval: 50,
+		AdmissionTTL:     10 * time.Minute,
+	}
+	w := c.GetWaitingRoom()
+	if w == nil {
+		return p
+	}
+	if w.Interval != nil && w.Interval.AsDuration() > 0 {
+		p.Interval = w.Interval.AsDuration()
+	}
+	if w.AdmitPerInterval > 0 {
+		p.AdmitPerInterval = int64(w.AdmitPerInterval)
+	}
+	if w.AdmissionTtl != nil && w.AdmissionTtl.AsDuration() > 0 {
+		p.AdmissionTTL = w.AdmissionTtl.AsDuration()
+	}
+	return p
+}
+
+// estimatedWait is how long until the visitor at position is let in.
+func (p *WaitingRoomPolicy) estimatedWait(position int64) time.Duration {
+	if position <= 0 {
+		return 0
+	}
+	rounds := (position + p.AdmitPerInterval - 1) / p.AdmitPerInterval
+	return time.Duration(rounds) * p.Interval
+}
+
+// AdmissionClaims is what an admission token vouches for.
+type AdmissionClaims struct {
+	EventID   uint64 `json:"e"`
+	UserID    uint64 `json:"u"`
+	ExpiresAt int64  `json:"x"` // unix seconds
+}
+
+// AdmissionSigner signs and checks admission tokens. They carry everything
+// needed to check them, so holds and bookings need not go to Redis.
+type AdmissionSigner struct {
+	key []byte
+}
+
+// ProvideAdmissionSigner builds the signer from the waiting room's token
+// key in config, which must be at least minSigningKeyLen bytes.
+func ProvideAdmissionSigner(c *conf.Data) (*AdmissionSigner, error) {
+	key := c.GetWaitingRoom().GetTokenKey()
+	if key == "" {
+		return nil, fmt.Errorf("waiting room token key is not configured")
+	}
+	if len(key) < minSigningKeyLen {
+		return nil, fmt.Errorf("waiting room token key must be at least %d bytes", minSigningKeyLen)
+	}
+	return &AdmissionSigner{key: []byte(key)}, nil
+}
+
+// Sign returns the admission token for claims.
+func (s *AdmissionSigner) Sign(claims AdmissionClaims) (string, error) {
+	body, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(body)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(s.mac(signed)), nil
+}
+
+// Verify checks that token is signed, unexpired and admits userID to
+// eventID, failing with ADMISSION_REQUIRED if not.
+func (s *AdmissionSigner) Verify(token string, eventID, userID uint64, now time.Time) error {
+	if token == "" {
+		return admissionRequired("missing", "event %d has a waiting room; join its queue to get an admission token", eventID)
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return admissionRequired("invalid", "admission token is malformed")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, s.mac(parts[0])) {
+		return admissionRequired("invalid", "admission token signature is bad")
+	}
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return admissionRequired("invalid", "admission token is malformed")
+	}
+	var claims AdmissionClaims
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return admissionRequired("invalid", "admission token is malformed")
+	}
+	if claims.EventID != eventID || claims.UserID != userID {
+		return admissionRequired("invalid", "admission token is not for user %d at event %d", userID, eventID)
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return admissionRequired("expired", "admission token expired at %s", time.Unix(claims.ExpiresAt, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (s *AdmissionSigner) mac(signed string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// admissionRequired is ADMISSION_REQUIRED with reason in metadata.
+func admissionRequired(reason, format string, args ...interface{}) error {
+	return bookingv1.ErrorAdmissionRequired(format, args...).WithMetadata(map[string]string{"reason": reason})
+}
+
+// checkAdmission lets userID hold or book seats of ev if ev has no waiting
+// room or token admits them to it.
+func (uc *BookingUsecase) checkAdmission(ev *eventv1.ShowEvent, userID uint64, token string) error {
+	if !ev.WaitingRoom {
+		return nil
+	}
+	return uc.admissions.Verify(token, ev.Id, userID, time.Now())
+}
+
+// QueueStatus is where a user stands in an event's queue.
+type QueueStatus struct {
+	EventID uint64
+	UserID  uint64
+	// Position counts the user and everyone ahead of them; 0 once admitted.
+	Position       int64
+	EstimatedWait  time.Duration
+	Admitted       bool
+	AdmissionToken string
+	TokenExpiresAt time.Time
+}
+
+// WaitingRoomUsecase queues visitors of events with a waiting room and lets
+// them in at the policy's rate.
+type WaitingRoomUsecase struct {
+	repo        WaitingRoomRepo
+	leases      LeaseRepo
+	eventClient eventv1.EventServiceClient
+	signer      *AdmissionSigner
+	policy      *WaitingRoomPolicy
+	log         *log.Helper
+}
+
+func NewWaitingRoomUsecase(repo WaitingRoomRepo, leases LeaseRepo, eventClient eventv1.EventServiceClient, signer *AdmissionSigner, policy *WaitingRoomPolicy, logger log.Logger) *WaitingRoomUsecase {
+	return &WaitingRoomUsecase{
+		repo:        repo,
+		leases:      leases,
+		eventClient: eventClient,
+		signer:      signer,
+		policy:      policy,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Interval is how often the queues move forward.
+func (uc *WaitingRoomUsecase) Interval() time.Duration {
+	return uc.policy.Interval
+}
+
+// Join queues userID for the event, which must have a waiting room.
+func (uc *WaitingRoomUsecase) Join(ctx context.Context, eventID, userID uint64) (*QueueStatus, error) {
+	if userID == 0 {
+		return nil, fmt.Errorf("user_id is required")
+	}
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: eventID})
+	if err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	if !evResp.ShowEvent.WaitingRoom {
+		return nil, fmt.Errorf("event %d has no waiting room", eventID)
+	}
+	entry, err := uc.repo.Join(ctx, eventID, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("User %d joined the queue of event %d as number %d", userID, eventID, entry.Number)
+	return uc.status(ctx, eventID, userID, entry)
+}
+
+// Status reports where userID stands in the event's queue, admitting them
+// if their turn has come.
+func (uc *WaitingRoomUsecase) Status(ctx context.Context, eventID, userID uint64) (*QueueStatus, error) {
+	entry, err := uc.repo.Get(ctx, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, bookingv1.ErrorNotInQueue("user %d is not in the queue of event %d", userID, eventID)
+	}
+	return uc.status(ctx, eventID, userID, entry)
+}
+
+// status builds userID's status from their entry. The first time a user is
+// seen past the front of the queue their admission starts; it lasts the
+// policy's admission TTL, after which they have to queue again.
+func (uc *WaitingRoomUsecase) status(ctx context.Context, eventID, userID uint64, entry *QueueEntry) (*QueueStatus, error) {
+	s := &QueueStatus{EventID: eventID, UserID: userID}
+	if entry.Number > entry.Serving {
+		s.Position = entry.Number - entry.Serving
+		s.EstimatedWait = uc.policy.estimatedWait(s.Position)
+		return s, nil
+	}
+	now := time.Now()
+	until := entry.AdmittedUntil
+	if until.IsZero() {
+		var err error
+		until, err = uc.repo.Admit(ctx, eventID, userID, now.Add(uc.policy.AdmissionTTL))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !now.Before(until) {
+		return nil, bookingv1.ErrorNotInQueue("admission of user %d to event %d lapsed at %s; join the queue again", userID, eventID, until.UTC().Format(time.RFC3339))
+	}
+	token, err := uc.signer.Sign(AdmissionClaims{EventID: eventID, UserID: userID, ExpiresAt: until.Unix()})
+	if err != nil {
+		return nil, err
+	}
+	s.Admitted, s.AdmissionToken, s.TokenExpiresAt = true, token, until
+	return s, nil
+}
+
+// Advance lets the next visitors of every queue in. Only the replica
+// holding the waiting room lease does any work, so the rate holds however
+// many replicas run. It returns how many visitors it let in.
+func (uc *WaitingRoomUsecase) Advance(ctx context.Context) (int64, error) {
+	ctx, release, ok, err := holdLease(ctx, uc.leases, "waiting-room", uc.policy.Interval)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer release()
+	events, err := uc.repo.ListQueues(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var admitted int64
+	for _, eventID := range events {
+		n, err := uc.repo.Advance(ctx, eventID, uc.policy.AdmitPerInterval)
+		if err != nil {
+			uc.log.Errorf("Failed to advance the queue of event %d: %v", eventID, err)
+			continue
+		}
+		admitted += n
+	}
+	return admitted, nil
+}
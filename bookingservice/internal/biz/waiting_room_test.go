@@ -0,0 +1,84 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+func TestProvideAdmissionSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr string
+	}{
+		{name: "long key", key: testKey1},
+		{name: "no key", wantErr: "not configured"},
+		{name: "short key", key: "my_admission_key", wantErr: "at least 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ProvideAdmissionSigner(&conf.Data{WaitingRoom: &conf.Data_WaitingRoom{TokenKey: tt.key}})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("err = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAdmissionSignerVerify(t *testing.T) {
+	signer := &AdmissionSigner{key: []byte(testKey1)}
+	now := time.Unix(1_700_000_000, 0)
+	token, err := signer.Sign(AdmissionClaims{EventID: 1, UserID: 5, ExpiresAt: now.Add(time.Minute).Unix()})
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	parts := strings.Split(token, ".")
+
+	tests := []struct {
+		name       string
+		signer     *AdmissionSigner
+		token      string
+		eventID    uint64
+		userID     uint64
+		now        time.Time
+		wantReason string
+	}{
+		{name: "valid", signer: signer, token: token, eventID: 1, userID: 5, now: now},
+		{name: "missing", signer: signer, eventID: 1, userID: 5, now: now, wantReason: "missing"},
+		{name: "expired", signer: signer, token: token, eventID: 1, userID: 5, now: now.Add(time.Minute), wantReason: "expired"},
+		{name: "other event", signer: signer, token: token, eventID: 2, userID: 5, now: now, wantReason: "invalid"},
+		{name: "other user", signer: signer, token: token, eventID: 1, userID: 6, now: now, wantReason: "invalid"},
+		{name: "other key", signer: &AdmissionSigner{key: []byte(testKey2)}, token: token, eventID: 1, userID: 5, now: now, wantReason: "invalid"},
+		{name: "claims changed", signer: signer, token: parts[0] + "x." + parts[1], eventID: 1, userID: 5, now: now, wantReason: "invalid"},
+		{name: "malformed", signer: signer, token: parts[0], eventID: 1, userID: 5, now: now, wantReason: "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.signer.Verify(tt.token, tt.eventID, tt.userID, tt.now)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("Verify: %v", err)
+				}
+				return
+			}
+			if !bookingv1.IsAdmissionRequired(err) {
+				t.Fatalf("err = %v, want ADMISSION_REQUIRED", err)
+			}
+			if reason := errors.FromError(err).Metadata["reason"]; reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bookingv1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+	eventv1 "eventservice/api/eventservice/v1"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TopicWaitlistOffered is the outbox topic for a waitlist offer.
+const TopicWaitlistOffered = "waitlist.offered"
+
+// WaitlistEntry is a user queued for seats of an event. An OFFERED entry
+// reserves SeatCount of the event's available seats until OfferExpiresAt.
+type WaitlistEntry struct {
+	ID             uint64
+	EventID        uint64
+	UserID         uint64
+	SeatCount      int32
+	State          bookingv1.WaitlistState
+	OfferExpiresAt time.Time
+	BookingID      uint64
+	CreatedAt      time.Time
+}
+
+// WaitlistRepo stores waitlist entries.
+type WaitlistRepo interface {
+	// Join queues entry as WAITING. It fails with ErrorAlreadyOnWaitlist if
+	// the user already has a WAITING or OFFERED entry for the event.
+	Join(ctx context.Context, entry *WaitlistEntry) (*WaitlistEntry, error)
+	Get(ctx context.Context, id uint64) (*WaitlistEntry, error)
+	// Position returns the 1-based place of a WAITING entry in its queue.
+	Position(ctx context.Context, entry *WaitlistEntry) (int32, error)
+	// LockQueue returns the event's WAITING and OFFERED entries in join
+	// order, locked until the surrounding transaction ends.
+	LockQueue(ctx context.Context, eventID uint64) ([]*WaitlistEntry, error)
+	// Offer moves a WAITING entry to OFFERED until expiresAt.
+	Offer(ctx context.Context, id uint64, expiresAt time.Time) (bool, error)
+	// UpdateState sets the entry's state to `to` only if it is still
+	// `from`, and reports whether it did. bookingID is stored when non-zero.
+	UpdateState(ctx context.Context, id uint64, from, to bookingv1.WaitlistState, bookingID uint64) (bool, error)
+	// ReservedSeats counts the seats of the event's open offers, leaving
+	// out those made to exceptUserID.
+	ReservedSeats(ctx context.Context, eventID, exceptUserID uint64) (int32, error)
+	// ListLapsedOffers returns up to limit OFFERED entries that expired
+	// before the cutoff.
+	ListLapsedOffers(ctx context.Context, before time.Time, limit int) ([]*WaitlistEntry, error)
+	// ListWaitingEvents returns up to limit events with WAITING entries.
+	ListWaitingEvents(ctx context.Context, limit int) ([]uint64, error)
+}
+
+// WaitlistPolicy controls waitlist offers.
+type WaitlistPolicy struct {
+	Interval  time.Duration
+	OfferTTL  time.Duration
+	BatchSize int
+}
+
+// ProvideWaitlistPolicy reads the waitlist policy from config, falling back
+// to 15 minute offers and a pass over the waitlists every 10 seconds.
+func ProvideWaitlistPolicy(c *conf.Data) *WaitlistPolicy {
+	p := &WaitlistPolicy{
+		Interval:  10 * time.Second,
+		OfferTTL:  15 * time.Minute,
+		BatchSize: 100,
+	}
+	w := c.GetWaitlist()
+	if w == nil {
+		return p
+	}
+	if w.Interval != nil && w.Interval.AsDuration() > 0 {
+		p.Interval = w.Interval.AsDuration()
+	}
+	if w.OfferTtl != nil && w.OfferTtl.AsDuration() > 0 {
+		p.OfferTTL = w.OfferTtl.AsDuration()
+	}
+	if w.BatchSize > 0 {
+		p.BatchSize = int(w.BatchSize)
+	}
+	return p
+}
+
+// JoinWaitlist queues the user for seatCount seats of the event.
+func (uc *BookingUsecase) JoinWaitlist(ctx context.Context, eventID, userID uint64, seatCount int32) (*WaitlistEntry, error) {
+	if seatCount <= 0 {
+		return nil, fmt.Errorf("seat_count must be positive")
+	}
+	valid, err := uc.eventClient.ValidateUser(ctx, &eventv1.ValidateUserRequest{Id: userID})
+	if err != nil || !valid.Found {
+		return nil, fmt.Errorf("user not found")
+	}
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: eventID})
+	if err != nil {
+		return nil, fmt.Errorf("event not found")
+	}
+	if seatCount > evResp.ShowEvent.TotalSeats {
+		return nil, fmt.Errorf("event has only %d seats", evResp.ShowEvent.TotalSeats)
+	}
+
+	entry, err := uc.waitlist.Join(ctx, &WaitlistEntry{
+		EventID:   eventID,
+		UserID:    userID,
+		SeatCount: seatCount,
+		State:     bookingv1.WaitlistState_WAITLIST_WAITING,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("User %d joined the waitlist of event %d for %d seats (entry %d)", userID, eventID, seatCount, entry.ID)
+
+	// Seats may already be free, e.g. when the buyer lost a race for them
+	if _, err := uc.offerWaitlist(ctx, eventID); err != nil {
+		uc.log.Errorf("Failed to offer seats of event %d: %v", eventID, err)
+	}
+	return uc.waitlist.Get(ctx, entry.ID)
+}
+
+func (uc *BookingUsecase) GetWaitlistEntry(ctx context.Context, id uint64) (*WaitlistEntry, error) {
+	return uc.waitlist.Get(ctx, id)
+}
+
+// WaitlistPosition is the 1-based queue place of a WAITING entry, and 0
+// for any other state.
+func (uc *BookingUsecase) WaitlistPosition(ctx context.Context, entry *WaitlistEntry) (int32, error) {
+	if entry.State != bookingv1.WaitlistState_WAITLIST_WAITING {
+		return 0, nil
+	}
+	return uc.waitlist.Position(ctx, entry)
+}
+
+// LeaveWaitlist takes the user off the waitlist. Seats of an open offer go
+// to the next entries in the queue.
+func (uc *BookingUsecase) LeaveWaitlist(ctx context.Context, id, userID uint64) (*WaitlistEntry, error) {
+	entry, err := uc.ownWaitlistEntry(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	from := entry.State
+	if from != bookingv1.WaitlistState_WAITLIST_WAITING && from != bookingv1.WaitlistState_WAITLIST_OFFERED {
+		return nil, bookingv1.ErrorInvalidStatusTransition("waitlist entry %d is already %s", id, from)
+	}
+	ok, err := uc.waitlist.UpdateState(ctx, id, from, bookingv1.WaitlistState_WAITLIST_LEFT, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, bookingv1.ErrorInvalidStatusTransition("waitlist entry %d is no longer %s", id, from)
+	}
+	uc.log.Infof("User %d left the waitlist of event %d (entry %d)", userID, entry.EventID, id)
+
+	if from == bookingv1.WaitlistState_WAITLIST_OFFERED {
+		if _, err := uc.offerWaitlist(ctx, entry.EventID); err != nil {
+			uc.log.Errorf("Failed to re-offer seats of event %d: %v", entry.EventID, err)
+		}
+	}
+	entry.State = bookingv1.WaitlistState_WAITLIST_LEFT
+	return entry, nil
+}
+
+// AcceptWaitlistOffer books seatIDs for the user of an open offer. The
+// booking starts PENDING like any other, and the offer is marked ACCEPTED
+// in the same transaction.
+func (uc *BookingUsecase) AcceptWaitlistOffer(ctx context.Context, id, userID uint64, seatIDs []string) (*bookingv1.Booking, error) {
+	entry, err := uc.ownWaitlistEntry(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.State != bookingv1.WaitlistState_WAITLIST_OFFERED || !time.Now().Before(entry.OfferExpiresAt) {
+		return nil, bookingv1.ErrorWaitlistOfferUnavailable("waitlist entry %d has no open offer", id)
+	}
+	if len(seatIDs) == 0 || int32(len(seatIDs)) > entry.SeatCount {
+		return nil, fmt.Errorf("choose between 1 and %d seats", entry.SeatCount)
+	}
+
+	req := &bookingv1.CreateBookingRequest{
+		UserId:  entry.UserID,
+		EventId: entry.EventID,
+		SeatIds: seatIDs,
+	}
+	// The offer was made to the user, so they need not queue for it
+	booking, err := uc.create(ctx, req, false, func(ctx context.Context, booking *bookingv1.Booking) error {
+		ok, err := uc.waitlist.UpdateState(ctx, id, bookingv1.WaitlistState_WAITLIST_OFFERED, bookingv1.WaitlistState_WAITLIST_ACCEPTED, booking.Id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return bookingv1.ErrorWaitlistOfferUnavailable("waitlist entry %d has no open offer", id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.log.Infof("Waitlist offer %d accepted: booking_id=%d", id, booking.Id)
+	return booking, nil
+}
+
+func (uc *BookingUsecase) ownWaitlistEntry(ctx context.Context, id, userID uint64) (*WaitlistEntry, error) {
+	entry, err := uc.waitlist.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry.UserID != userID {
+		return nil, bookingv1.ErrorWaitlistEntryNotOwned("waitlist entry %d belongs to another user", id)
+	}
+	return entry, nil
+}
+
+// offerWaitlist offers the event's free seats, net of open offers, to the
+// oldest WAITING entries that fit, and returns how many offers it made.
+// The queue stays locked while the offers are made so that two passes over
+// the same event cannot hand out the same seats.
+func (uc *BookingUsecase) offerWaitlist(ctx context.Context, eventID uint64) (int, error) {
+	evResp, err := uc.eventClient.GetShowEvent(ctx, &eventv1.GetShowEventRequest{Id: eventID})
+	if err != nil {
+		return 0, err
+	}
+	offered := 0
+	err = uc.tx.InTx(ctx, func(ctx context.Context) error {
+		offered = 0
+		queue, err := uc.waitlist.LockQueue(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		free := evResp.ShowEvent.AvailableSeats
+		now := time.Now()
+		for _, e := range queue {
+			if e.State == bookingv1.WaitlistState_WAITLIST_OFFERED && now.Before(e.OfferExpiresAt) {
+				free -= e.SeatCount
+			}
+		}
+		expiresAt := now.Add(uc.waitlistPolicy.OfferTTL)
+		for _, e := range queue {
+			if free <= 0 {
+				break
+			}
+			if e.State != bookingv1.WaitlistState_WAITLIST_WAITING || e.SeatCount > free {
+				continue
+			}
+			ok, err := uc.waitlist.Offer(ctx, e.ID, expiresAt)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			if err := uc.outbox.Add(ctx, &OutboxMessage{Topic: TopicWaitlistOffered, WaitlistEntryID: e.ID}); err != nil {
+				return err
+			}
+			free -= e.SeatCount
+			offered++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if offered > 0 {
+		uc.log.Infof("Made %d waitlist offers for event %d", offered, eventID)
+	}
+	return offered, nil
+}
+
+// WaitlistUsecase expires lapsed offers and offers free seats to waiting
+// users in the background. Seats given back by bookings are offered right
+// away by BookingUsecase; this pass picks up lapsed offers and seats added
+// to an event some other way.
+type WaitlistUsecase struct {
+	bookings *BookingUsecase
+	repo     WaitlistRepo
+	leases   LeaseRepo
+	policy   *WaitlistPolicy
+	log      *log.Helper
+}
+
+func NewWaitlistUsecase(bookings *BookingUsecase, repo WaitlistRepo, leases LeaseRepo, policy *WaitlistPolicy, logger log.Logger) *WaitlistUsecase {
+	return &WaitlistUsecase{
+		bookings: bookings,
+		repo:     repo,
+		leases:   leases,
+		policy:   policy,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Interval is how often Process should run.
+func (uc *WaitlistUsecase) Interval() time.Duration {
+	return uc.policy.Interval
+}
+
+// Process expires one batch of lapsed offers and then offers free seats on
+// every event with a queue. It returns how many offers it made. Only the
+// replica holding the waitlist lease does any work.
+func (uc *WaitlistUsecase) Process(ctx context.Context) (int, error) {
+	ctx, release, ok, err := holdLease(ctx, uc.leases, "waitlist", uc.policy.Interval)
+	if err != nil || !ok {
+		return 0, err
+	}
+	defer release()
+	lapsed, err := uc.repo.ListLapsedOffers(ctx, time.Now(), uc.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range lapsed {
+		ok, err := uc.repo.UpdateState(ctx, e.ID, bookingv1.WaitlistState_WAITLIST_OFFERED, bookingv1.WaitlistState_WAITLIST_EXPIRED, 0)
+		if err != nil {
+			uc.log.Errorf("Failed to expire waitlist offer %d: %v", e.ID, err)
+			continue
+		}
+		if ok {
+			uc.log.Infof("Waitlist offer %d for event %d lapsed", e.ID, e.EventID)
+		}
+	}
+
+	events, err := uc.repo.ListWaitingEvents(ctx, uc.policy.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	offered := 0
+	for _, eventID := range events {
+		n, err := uc.bookings.offerWaitlist(ctx, eventID)
+		if err != nil {
+			uc.log.Errorf("Failed to offer seats of event %d: %v", eventID, err)
+			continue
+		}
+		offered += n
+	}
+	return offered, nil
+}
@@ -2,66 +2,188 @@ package data
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	v1 "bookingservice/api/bookingservice/v1"
 	"bookingservice/internal/biz"
+	moneyv1 "eventservice/api/money/v1"
+	"eventservice/pkg/money"
 
-	"github.com/redis/go-redis/v9"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type bookingRepo struct {
 	db    *gorm.DB
-	redis *redis.Client
+	locks SeatLocker
+	feed  *SeatFeed
 }
 
 // Booking DB model
 type Booking struct {
-	ID        uint64         `gorm:"primaryKey;autoIncrement"`
-	UserID    uint64         `gorm:"index"`
-	EventID   uint64         `gorm:"index"`
-	SeatIDs   datatypes.JSON `gorm:"type:json"`
-	Status    string
-	TotalCost float64
-	CreatedAt time.Time
+	ID      uint64 `gorm:"primaryKey;autoIncrement"`
+	UserID  uint64 `gorm:"index"`
+	EventID uint64 `gorm:"index"`
+	// LegacySeatIDs is the JSON seat list bookings carried before seats moved
+	// to booking_seats. It is only read to backfill that table.
+	LegacySeatIDs datatypes.JSON `gorm:"column:seat_ids;type:json"`
+	Seats         []BookingSeat  `gorm:"foreignKey:BookingID"`
+	Status        string         `gorm:"index"`
+	// TotalCostMinor is what the booking costs in minor units of Currency.
+	TotalCostMinor int64     `gorm:"not null;default:0"`
+	Currency       string    `gorm:"size:3;not null;default:'INR'"`
+	TicketCode     string    `gorm:"size:32;index"`
+	CreatedAt      time.Time `gorm:"index"`
+	// PromoCode is the code the booking was made with and DiscountMinor
+	// what it took off, in minor units of Currency.
+	PromoCode     string `gorm:"size:32;index"`
+	DiscountMinor int64  `gorm:"not null;default:0"`
+	// HoldToken is the token the seats are held under while the booking
+	// is PENDING, so they can be let go once it moves on.
+	HoldToken string `gorm:"size:64"`
 }
 
-func NewBookingRepo(db *gorm.DB, redis *redis.Client) biz.BookingRepo {
-	db.AutoMigrate(&Booking{})
-	return &bookingRepo{db: db, redis: redis}
+// BookingSeat DB model. Status mirrors the booking's, and a seat can belong
+// to only one CONFIRMED booking per event.
+type BookingSeat struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	BookingID uint64 `gorm:"not null;index"`
+	EventID   uint64 `gorm:"not null;uniqueIndex:idx_booking_seats_confirmed,where:status = 'CONFIRMED'"`
+	SeatID    string `gorm:"size:64;not null;uniqueIndex:idx_booking_seats_confirmed"`
+	Status    string `gorm:"size:20;not null"`
+}
+
+func NewBookingRepo(db *gorm.DB, locks SeatLocker, feed *SeatFeed, logger log.Logger) (biz.BookingRepo, error) {
+	if err := db.AutoMigrate(&Booking{}, &BookingSeat{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate bookings: %w", err)
+	}
+
+	// Move seats of older bookings out of the JSON column
+	filled, err := backfillBookingSeats(db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill booking seats: %w", err)
+	}
+	if filled > 0 {
+		log.NewHelper(logger).Infof("Backfilled booking_seats for %d bookings", filled)
+	}
+
+	// Move totals of older bookings over to minor units
+	moved, err := money.MigrateMinorUnits(db, "bookings", "total_cost", "total_cost_minor")
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill booking totals: %w", err)
+	}
+	if moved > 0 {
+		log.NewHelper(logger).Infof("Backfilled total_cost_minor for %d bookings", moved)
+	}
+	return &bookingRepo{db: db, locks: locks, feed: feed}, nil
 }
 
 func toProto(b *Booking) *v1.Booking {
-	var seatIDs []string
-	_ = json.Unmarshal(b.SeatIDs, &seatIDs)
-	return &v1.Booking{
-		Id:        b.ID,
-		UserId:    b.UserID,
-		EventId:   b.EventID,
-		SeatIds:   seatIDs,
-		Status:    b.Status,
-		TotalCost: float32(b.TotalCost),
-		CreatedAt: b.CreatedAt.Format(time.RFC3339),
+	seatIDs := make([]string, 0, len(b.Seats))
+	for _, s := range b.Seats {
+		seatIDs = append(seatIDs, s.SeatID)
 	}
+	booking := &v1.Booking{
+		Id:         b.ID,
+		UserId:     b.UserID,
+		EventId:    b.EventID,
+		SeatIds:    seatIDs,
+		Status:     v1.BookingStatus(v1.BookingStatus_value[b.Status]),
+		TotalCost:  &moneyv1.Money{AmountMinor: b.TotalCostMinor, Currency: b.Currency},
+		CreatedAt:  b.CreatedAt.Format(time.RFC3339),
+		TicketCode: b.TicketCode,
+	}
+	if b.PromoCode != "" {
+		booking.PromoCode = b.PromoCode
+		booking.Discount = &moneyv1.Money{AmountMinor: b.DiscountMinor, Currency: b.Currency}
+	}
+	return booking
+}
+
+// backfillBookingSeats copies the seats of bookings made before the
+// booking_seats table existed out of their JSON column, and returns how many
+// bookings it filled in. Seats that clash with an already CONFIRMED seat are
+// skipped rather than failing startup.
+func backfillBookingSeats(db *gorm.DB) (int, error) {
+	var legacy []Booking
+	err := db.Where("seat_ids IS NOT NULL AND NOT EXISTS (SELECT 1 FROM booking_seats s WHERE s.booking_id = bookings.id)").
+		Find(&legacy).Error
+	if err != nil {
+		return 0, err
+	}
+	filled := 0
+	for _, b := range legacy {
+		var seatIDs []string
+		if err := json.Unmarshal(b.LegacySeatIDs, &seatIDs); err != nil || len(seatIDs) == 0 {
+			continue
+		}
+		seats := bookingSeats(&v1.Booking{
+			Id:      b.ID,
+			EventId: b.EventID,
+			SeatIds: seatIDs,
+			Status:  v1.BookingStatus(v1.BookingStatus_value[b.Status]),
+		})
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&seats).Error; err != nil {
+			return filled, err
+		}
+		filled++
+	}
+	return filled, nil
 }
 
 // ---------------- CRUD ----------------
-func (r *bookingRepo) Create(ctx context.Context, booking *v1.Booking) (*v1.Booking, error) {
-	seatJSON, _ := json.Marshal(booking.SeatIds)
+
+func bookingSeats(booking *v1.Booking) []BookingSeat {
+	seats := make([]BookingSeat, 0, len(booking.SeatIds))
+	for _, seatID := range booking.SeatIds {
+		seats = append(seats, BookingSeat{
+			BookingID: booking.Id,
+			EventID:   booking.EventId,
+			SeatID:    seatID,
+			Status:    booking.Status.String(),
+		})
+	}
+	return seats
+}
+
+// withSeats loads each booking's seats in the order they were added.
+func withSeats(db *gorm.DB) *gorm.DB {
+	return db.Preload("Seats", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+}
+
+// isUniqueViolation reports whether err is a Postgres unique constraint
+// violation, i.e. a seat is already taken by a CONFIRMED booking.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func (r *bookingRepo) Create(ctx context.Context, booking *v1.Booking, holdToken string) (*v1.Booking, error) {
 	b := &Booking{
-		UserID:    booking.UserId,
-		EventID:   booking.EventId,
-		SeatIDs:   seatJSON,
-		Status:    booking.Status,
-		TotalCost: float64(booking.TotalCost),
-		CreatedAt: time.Now(),
-	}
-	if err := r.db.WithContext(ctx).Create(b).Error; err != nil {
+		UserID:         booking.UserId,
+		EventID:        booking.EventId,
+		Seats:          bookingSeats(booking),
+		Status:         booking.Status.String(),
+		TotalCostMinor: booking.GetTotalCost().GetAmountMinor(),
+		Currency:       booking.GetTotalCost().GetCurrency(),
+		TicketCode:     booking.TicketCode,
+		CreatedAt:      time.Now(),
+		PromoCode:      booking.PromoCode,
+		DiscountMinor:  booking.GetDiscount().GetAmountMinor(),
+		HoldToken:      holdToken,
+	}
+	if err := dbFrom(ctx, r.db).Create(b).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorSeatsUnavailable("seats %v are already booked", booking.SeatIds)
+		}
 		return nil, err
 	}
 	return toProto(b), nil
@@ -69,81 +191,340 @@ func (r *bookingRepo) Create(ctx context.Context, booking *v1.Booking) (*v1.Book
 
 func (r *bookingRepo) Get(ctx context.Context, id uint64) (*v1.Booking, error) {
 	var b Booking
-	if err := r.db.WithContext(ctx).First(&b, id).Error; err != nil {
+	if err := withSeats(dbFrom(ctx, r.db)).First(&b, id).Error; err != nil {
 		return nil, err
 	}
 	return toProto(&b), nil
 }
 
-func (r *bookingRepo) List(ctx context.Context) ([]*v1.Booking, error) {
+func (r *bookingRepo) GetHoldToken(ctx context.Context, id uint64) (string, error) {
+	var b Booking
+	if err := dbFrom(ctx, r.db).Select("hold_token").First(&b, id).Error; err != nil {
+		return "", err
+	}
+	return b.HoldToken, nil
+}
+
+// bookingSortColumn maps a sort option to its column and direction.
+func bookingSortColumn(sort v1.BookingSort) (string, bool) {
+	switch sort {
+	case v1.BookingSort_CREATED_AT_ASC:
+		return "created_at", false
+	case v1.BookingSort_TOTAL_COST_DESC:
+		return "total_cost_minor", true
+	case v1.BookingSort_TOTAL_COST_ASC:
+		return "total_cost_minor", false
+	default:
+		return "created_at", true
+	}
+}
+
+func (r *bookingRepo) List(ctx context.Context, f biz.BookingFilter) (*biz.BookingPage, error) {
+	q := withSeats(dbFrom(ctx, r.db))
+	if f.UserID != 0 {
+		q = q.Where("user_id = ?", f.UserID)
+	}
+	if f.EventID != 0 {
+		q = q.Where("event_id = ?", f.EventID)
+	}
+	if f.Status != v1.BookingStatus_BOOKING_STATUS_UNSPECIFIED {
+		q = q.Where("status = ?", f.Status.String())
+	}
+	if !f.CreatedAfter.IsZero() {
+		q = q.Where("created_at >= ?", f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		q = q.Where("created_at < ?", f.CreatedBefore)
+	}
+
+	// Keyset pagination on (sort column, id)
+	col, desc := bookingSortColumn(f.Sort)
+	op, dir := ">", "ASC"
+	if desc {
+		op, dir = "<", "DESC"
+	}
+	if f.After != nil {
+		var key interface{} = f.After.CreatedAt
+		if col == "total_cost_minor" {
+			key = f.After.TotalCost
+		}
+		q = q.Where(fmt.Sprintf("(%s, id) %s (?, ?)", col, op), key, f.After.ID)
+	}
+
 	var bookings []Booking
-	if err := r.db.WithContext(ctx).Find(&bookings).Error; err != nil {
+	if err := q.Order(col + " " + dir).Order("id " + dir).Limit(f.Limit + 1).Find(&bookings).Error; err != nil {
 		return nil, err
 	}
-	res := make([]*v1.Booking, 0, len(bookings))
+
+	page := &biz.BookingPage{}
+	if len(bookings) > f.Limit {
+		bookings = bookings[:f.Limit]
+		last := bookings[len(bookings)-1]
+		page.Next = &biz.BookingCursor{
+			Sort:      f.Sort,
+			CreatedAt: last.CreatedAt,
+			TotalCost: last.TotalCostMinor,
+			ID:        last.ID,
+		}
+	}
+	page.Bookings = make([]*v1.Booking, 0, len(bookings))
 	for _, b := range bookings {
-		res = append(res, toProto(&b))
+		page.Bookings = append(page.Bookings, toProto(&b))
 	}
-	return res, nil
+	return page, nil
 }
 
+// Update saves the booking's seats and cost. Status is left alone; it only
+// changes through UpdateStatus.
 func (r *bookingRepo) Update(ctx context.Context, booking *v1.Booking) (*v1.Booking, error) {
-	seatJSON, _ := json.Marshal(booking.SeatIds)
 	var b Booking
-	if err := r.db.WithContext(ctx).First(&b, booking.Id).Error; err != nil {
-		return nil, err
-	}
-	b.SeatIDs = seatJSON
-	b.Status = booking.Status
-	b.TotalCost = float64(booking.TotalCost)
-	if err := r.db.WithContext(ctx).Save(&b).Error; err != nil {
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&b, booking.Id).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("booking_id = ?", b.ID).Delete(&BookingSeat{}).Error; err != nil {
+			return err
+		}
+		seats := bookingSeats(&v1.Booking{
+			Id:      b.ID,
+			EventId: b.EventID,
+			SeatIds: booking.SeatIds,
+			Status:  v1.BookingStatus(v1.BookingStatus_value[b.Status]),
+		})
+		if len(seats) > 0 {
+			if err := tx.Create(&seats).Error; err != nil {
+				return err
+			}
+		}
+		b.Seats = seats
+		b.TotalCostMinor = booking.GetTotalCost().GetAmountMinor()
+		return tx.Model(&b).Update("total_cost_minor", b.TotalCostMinor).Error
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorSeatsUnavailable("seats %v are already booked", booking.SeatIds)
+		}
 		return nil, err
 	}
 	return toProto(&b), nil
 }
 
-func (r *bookingRepo) Cancel(ctx context.Context, id uint64) (*v1.Booking, error) {
+// UpdateStatus moves the booking and its seats to `to` in one transaction.
+// Confirming fails with SEATS_UNAVAILABLE if another CONFIRMED booking
+// already has one of the seats. Seats that become or stop being booked are
+// published to the seat feed.
+func (r *bookingRepo) UpdateStatus(ctx context.Context, id uint64, from, to v1.BookingStatus) (bool, error) {
+	updated := false
+	var seats []BookingSeat
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Booking{}).
+			Where("id = ? AND status = ?", id, from.String()).
+			Update("status", to.String())
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		updated = true
+		if err := tx.Model(&BookingSeat{}).
+			Where("booking_id = ?", id).
+			Update("status", to.String()).Error; err != nil {
+			return err
+		}
+		return tx.Where("booking_id = ?", id).Order("id").Find(&seats).Error
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, v1.ErrorSeatsUnavailable("seats of booking %d are already booked", id)
+		}
+		return false, err
+	}
+	if updated && len(seats) > 0 {
+		seatIDs := make([]string, 0, len(seats))
+		for _, seat := range seats {
+			seatIDs = append(seatIDs, seat.SeatID)
+		}
+		if to == v1.BookingStatus_CONFIRMED {
+			r.feed.publish(ctx, seats[0].EventID, v1.SeatUpdateKind_SEAT_BOOKED, seatIDs, time.Time{})
+		} else if from == v1.BookingStatus_CONFIRMED {
+			r.feed.publish(ctx, seats[0].EventID, v1.SeatUpdateKind_SEAT_CANCELLED, seatIDs, time.Time{})
+		}
+	}
+	return updated, nil
+}
+
+func (r *bookingRepo) RemoveSeats(ctx context.Context, id uint64, status v1.BookingStatus, seatIDs []string, amount int64) (bool, error) {
+	removed := false
 	var b Booking
-	if err := r.db.WithContext(ctx).First(&b, id).Error; err != nil {
-		return nil, err
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Relative update, so concurrent removals of other seats add up
+		res := tx.Model(&Booking{}).
+			Where("id = ? AND status = ?", id, status.String()).
+			Update("total_cost_minor", gorm.Expr("total_cost_minor - ?", amount))
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		res = tx.Where("booking_id = ? AND seat_id IN ?", id, seatIDs).Delete(&BookingSeat{})
+		if res.Error != nil {
+			return res.Error
+		}
+		var left int64
+		if err := tx.Model(&BookingSeat{}).Where("booking_id = ?", id).Count(&left).Error; err != nil {
+			return err
+		}
+		if res.RowsAffected != int64(len(seatIDs)) || left == 0 {
+			return errSeatsChanged
+		}
+		removed = true
+		return tx.Select("id", "event_id").First(&b, id).Error
+	})
+	if errors.Is(err, errSeatsChanged) {
+		return false, nil
 	}
-	b.Status = "CANCELLED"
-	if err := r.db.WithContext(ctx).Save(&b).Error; err != nil {
-		return nil, err
+	if err != nil {
+		return false, err
 	}
-	return toProto(&b), nil
+	if removed && status == v1.BookingStatus_CONFIRMED {
+		r.feed.publish(ctx, b.EventID, v1.SeatUpdateKind_SEAT_CANCELLED, seatIDs, time.Time{})
+	}
+	return removed, nil
+}
+
+func (r *bookingRepo) SwapSeats(ctx context.Context, id uint64, status v1.BookingStatus, remove, add []string, delta int64) (bool, error) {
+	swapped := false
+	var b Booking
+	err := dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&Booking{}).
+			Where("id = ? AND status = ?", id, status.String()).
+			Update("total_cost_minor", gorm.Expr("total_cost_minor + ?", delta))
+		if res.Error != nil || res.RowsAffected != 1 {
+			return res.Error
+		}
+		if err := tx.Select("id", "event_id").First(&b, id).Error; err != nil {
+			return err
+		}
+		res = tx.Where("booking_id = ? AND seat_id IN ?", id, remove).Delete(&BookingSeat{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected != int64(len(remove)) {
+			return errSeatsChanged
+		}
+		seats := bookingSeats(&v1.Booking{Id: id, EventId: b.EventID, SeatIds: add, Status: status})
+		if err := tx.Create(&seats).Error; err != nil {
+			return err
+		}
+		swapped = true
+		return nil
+	})
+	if errors.Is(err, errSeatsChanged) {
+		return false, nil
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return false, v1.ErrorSeatsUnavailable("seats %v are already booked", add)
+		}
+		return false, err
+	}
+	if swapped && status == v1.BookingStatus_CONFIRMED {
+		r.feed.publish(ctx, b.EventID, v1.SeatUpdateKind_SEAT_CANCELLED, remove, time.Time{})
+		r.feed.publish(ctx, b.EventID, v1.SeatUpdateKind_SEAT_BOOKED, add, time.Time{})
+	}
+	return swapped, nil
+}
+
+func (r *bookingRepo) ChangeOwner(ctx context.Context, id, fromUserID, toUserID uint64, ticketCode string) (bool, error) {
+	res := dbFrom(ctx, r.db).Model(&Booking{}).
+		Where("id = ? AND user_id = ? AND status = ?", id, fromUserID, v1.BookingStatus_CONFIRMED.String()).
+		Updates(map[string]interface{}{"user_id": toUserID, "ticket_code": ticketCode})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// errSeatsChanged rolls back a RemoveSeats or SwapSeats that no longer
+// applies.
+var errSeatsChanged = errors.New("booking seats changed")
+
+// ListBookedSeats returns the seats of the event's CONFIRMED bookings.
+func (r *bookingRepo) ListBookedSeats(ctx context.Context, eventID uint64) ([]string, error) {
+	var seatIDs []string
+	err := dbFrom(ctx, r.db).Model(&BookingSeat{}).
+		Where("event_id = ? AND status = ?", eventID, v1.BookingStatus_CONFIRMED.String()).
+		Order("id").
+		Pluck("seat_id", &seatIDs).Error
+	return seatIDs, err
+}
+
+// ListUserSeats returns the seats of userID's PENDING and CONFIRMED
+// bookings of the event.
+func (r *bookingRepo) ListUserSeats(ctx context.Context, eventID, userID uint64) ([]string, error) {
+	var seatIDs []string
+	err := dbFrom(ctx, r.db).Model(&BookingSeat{}).
+		Joins("JOIN bookings ON bookings.id = booking_seats.booking_id").
+		Where("booking_seats.event_id = ? AND bookings.user_id = ? AND booking_seats.status IN ?", eventID, userID,
+			[]string{v1.BookingStatus_PENDING.String(), v1.BookingStatus_CONFIRMED.String()}).
+		Pluck("booking_seats.seat_id", &seatIDs).Error
+	return seatIDs, err
 }
 
 // ---------------- Lock / Unlock ----------------
-func (r *bookingRepo) LockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) (bool, error) {
-	key := fmt.Sprintf("booking:lock:%d:%s", eventID, seatID)
-	return r.redis.SetNX(ctx, key, userID, 2*time.Minute).Result()
+//
+// The holds themselves are kept by the SeatLocker; the repo hands out hold
+// tokens and tells the seat feed about every change.
+
+func newHoldToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (r *bookingRepo) HoldSeats(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	token := owner.Token
+	if token == "" {
+		var err error
+		if token, err = newHoldToken(); err != nil {
+			return nil, err
+		}
+	}
+	res, err := r.locks.Hold(ctx, eventID, seatIDs, owner, token, policy)
+	if err == nil && len(res.Conflicts) == 0 {
+		r.feed.publish(ctx, eventID, v1.SeatUpdateKind_SEAT_HELD, seatIDs, res.ExpiresAt)
+	}
+	return res, err
 }
 
-func (r *bookingRepo) UnlockSeat(ctx context.Context, eventID uint64, seatID string, userID uint64) error {
-	key := fmt.Sprintf("booking:lock:%d:%s", eventID, seatID)
-	return r.redis.Del(ctx, key).Err()
+func (r *bookingRepo) ExtendSeats(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	res, err := r.locks.Extend(ctx, eventID, seatIDs, owner, policy)
+	if err == nil && len(res.Conflicts) == 0 {
+		r.feed.publish(ctx, eventID, v1.SeatUpdateKind_SEAT_HELD, seatIDs, res.ExpiresAt)
+	}
+	return res, err
 }
 
-func (r *bookingRepo) GetLockedSeats(ctx context.Context, eventID uint64) ([]string, error) {
-	pattern := fmt.Sprintf("booking:lock:%d:*", eventID)
-	keys, err := r.redis.Keys(ctx, pattern).Result()
+func (r *bookingRepo) ReleaseSeats(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold) ([]string, error) {
+	notOwned, err := r.locks.Release(ctx, eventID, seatIDs, owner)
 	if err != nil {
 		return nil, err
 	}
-	seatIDs := []string{}
-	for _, k := range keys {
-		parts := strings.Split(k, ":")
-		if len(parts) == 4 {
-			seatIDs = append(seatIDs, parts[3])
-		}
+	if len(notOwned) == 0 {
+		r.feed.publish(ctx, eventID, v1.SeatUpdateKind_SEAT_RELEASED, seatIDs, time.Time{})
 	}
-	return seatIDs, nil
+	return notOwned, nil
+}
+
+func (r *bookingRepo) GetLockedSeats(ctx context.Context, eventID uint64) ([]*biz.LockedSeat, error) {
+	return r.locks.List(ctx, eventID)
 }
 
-func (r *bookingRepo) ListByEventAndStatus(ctx context.Context, eventID uint64, status string) ([]*v1.Booking, error) {
+func (r *bookingRepo) ListPendingBefore(ctx context.Context, before time.Time, limit int) ([]*v1.Booking, error) {
 	var bookings []Booking
-	if err := r.db.WithContext(ctx).Where("event_id = ? AND status = ?", eventID, status).Find(&bookings).Error; err != nil {
+	if err := withSeats(dbFrom(ctx, r.db)).
+		Where("status = ? AND created_at < ?", v1.BookingStatus_PENDING.String(), before).
+		Order("created_at").
+		Limit(limit).
+		Find(&bookings).Error; err != nil {
 		return nil, err
 	}
 	res := make([]*v1.Booking, 0, len(bookings))
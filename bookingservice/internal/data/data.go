@@ -4,6 +4,7 @@ import (
 	"fmt"
 	//"time"
 	"context"
+	"bookingservice/internal/biz"
 	"bookingservice/internal/conf"
 	
 
@@ -20,9 +21,24 @@ var ProviderSet = wire.NewSet(
 	NewData,
 	NewDB,
 	NewBookingRepo,
+	NewLeaseRepo,
+	NewIdempotencyRepo,
+	NewOutboxRepo,
+	NewSagaRepo,
+	NewWaitlistRepo,
+	NewSeatCancellationRepo, NewSeatChangeRepo, NewTicketRepo, NewPromoRepo,
+	NewWaitingRoomRepo,
+	NewTransferRepo,
+	NewStatusHistoryRepo,
+	NewTransaction,
+	NewSeatFeed,
+	wire.Bind(new(biz.SeatFeed), new(*SeatFeed)),
+	NewSeatLocker,
 	NewRedis,
 	ProvideEventClient,
 	ProvideNotificationClient,
+	ProvidePaymentClient,
+	ProvideUserClient,
 	
 )
 
@@ -38,6 +54,7 @@ func NewData(db *gorm.DB, logger log.Logger) (*Data, func(), error) {
 	// ✅ Run AutoMigrate 
 	err := db.AutoMigrate(
 		&Booking{},
+		&BookingSeat{},
 	)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to migrate database: %w", err)
@@ -76,7 +93,18 @@ func NewDB(c *conf.Data) (*gorm.DB, func(), error) {
 }
 
 
+// NewRedis connects to Redis, unless the seat lock backend keeps
+// everything in process, in which case there is no client.
 func NewRedis(c *conf.Data, logger log.Logger) (*redis.Client, func(), error) {
+    backend, err := seatLockBackend(c)
+    if err != nil {
+        return nil, nil, err
+    }
+    if backend == SeatLockMemory {
+        log.NewHelper(logger).Warn("seat lock backend is memory: running without Redis, fit for a single replica only")
+        return nil, func() {}, nil
+    }
+
     rdb := redis.NewClient(&redis.Options{
         Network: c.Redis.Network, // e.g. "tcp"
         Addr:    c.Redis.Addr,    // "127.0.0.1:6379"
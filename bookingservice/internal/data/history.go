@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// BookingStatusChange DB model. Rows are only ever inserted, in the
+// transaction that moves the booking.
+type BookingStatusChange struct {
+	ID         uint64 `gorm:"primaryKey;autoIncrement"`
+	BookingID  uint64 `gorm:"not null;index"`
+	FromStatus string `gorm:"size:32;not null"`
+	ToStatus   string `gorm:"size:32;not null"`
+	Actor      string `gorm:"size:32;not null"`
+	ActorID    string `gorm:"size:64"`
+	Reason     string `gorm:"size:255"`
+	ChangedAt  time.Time
+}
+
+type statusHistoryRepo struct {
+	db *gorm.DB
+}
+
+func NewStatusHistoryRepo(db *gorm.DB) biz.StatusHistoryRepo {
+	db.AutoMigrate(&BookingStatusChange{})
+	return &statusHistoryRepo{db: db}
+}
+
+func (r *statusHistoryRepo) Record(ctx context.Context, c *biz.StatusChange) error {
+	m := &BookingStatusChange{
+		BookingID:  c.BookingID,
+		FromStatus: c.From.String(),
+		ToStatus:   c.To.String(),
+		Actor:      c.Actor.String(),
+		ActorID:    c.ActorID,
+		Reason:     c.Reason,
+		ChangedAt:  c.ChangedAt,
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		return err
+	}
+	c.ID = m.ID
+	return nil
+}
+
+func (r *statusHistoryRepo) ListByBooking(ctx context.Context, bookingID uint64) ([]*biz.StatusChange, error) {
+	var models []BookingStatusChange
+	if err := dbFrom(ctx, r.db).
+		Where("booking_id = ?", bookingID).
+		Order("id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.StatusChange, 0, len(models))
+	for _, m := range models {
+		res = append(res, &biz.StatusChange{
+			ID:        m.ID,
+			BookingID: m.BookingID,
+			From:      v1.BookingStatus(v1.BookingStatus_value[m.FromStatus]),
+			To:        v1.BookingStatus(v1.BookingStatus_value[m.ToStatus]),
+			Actor:     v1.BookingActor(v1.BookingActor_value[m.Actor]),
+			ActorID:   m.ActorID,
+			Reason:    m.Reason,
+			ChangedAt: m.ChangedAt,
+		})
+	}
+	return res, nil
+}
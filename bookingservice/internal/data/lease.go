@@ -0,0 +1,58 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"bookingservice/internal/biz"
+	"bookingservice/internal/conf"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireLeaseScript takes the lease if it is free, or renews it if this
+// process already holds it.
+var acquireLeaseScript = redis.NewScript(`
+local cur = redis.call('GET', KEYS[1])
+if cur == ARGV[1] then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  return 1
+end
+if cur then
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+type leaseRepo struct {
+	redis *redis.Client
+	owner string
+}
+
+// NewLeaseRepo returns a biz.LeaseRepo backed by Redis, or one that always
+// grants the lease when running without Redis, since there is only the one
+// replica then.
+func NewLeaseRepo(c *conf.Data, redis *redis.Client) biz.LeaseRepo {
+	if inProcess(c) {
+		return localLeaseRepo{}
+	}
+	host, _ := os.Hostname()
+	return &leaseRepo{redis: redis, owner: fmt.Sprintf("%s:%d", host, os.Getpid())}
+}
+
+func (r *leaseRepo) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	n, err := acquireLeaseScript.Run(ctx, r.redis, []string{"booking:lease:" + name}, r.owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+type localLeaseRepo struct{}
+
+func (localLeaseRepo) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return true, nil
+}
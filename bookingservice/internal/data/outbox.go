@@ -0,0 +1,130 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// Outbox message states
+const (
+	outboxPending   = "PENDING"
+	outboxDelivered = "DELIVERED"
+	outboxFailed    = "FAILED"
+)
+
+// OutboxMessage DB model
+type OutboxMessage struct {
+	ID                 uint64 `gorm:"primaryKey;autoIncrement"`
+	Topic              string `gorm:"size:64;not null"`
+	BookingID          uint64 `gorm:"not null;index"`
+	WaitlistEntryID    uint64 `gorm:"not null;default:0"`
+	SeatCancellationID uint64 `gorm:"not null;default:0"`
+	SeatChangeID       uint64 `gorm:"not null;default:0"`
+	TransferID         uint64 `gorm:"not null;default:0"`
+	Status             string `gorm:"size:20;not null;index:idx_outbox_due,priority:1"`
+	Attempts           int32
+	NextAttemptAt      time.Time `gorm:"index:idx_outbox_due,priority:2"`
+	DeliveredAt        *time.Time
+	CreatedAt          time.Time
+}
+
+// OutboxAttempt DB model, one row per delivery attempt
+type OutboxAttempt struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	MessageID uint64 `gorm:"not null;index"`
+	Attempt   int32
+	Delivered bool
+	Detail    string
+	CreatedAt time.Time
+}
+
+type outboxRepo struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepo(db *gorm.DB) (biz.OutboxRepo, error) {
+	if err := db.AutoMigrate(&OutboxMessage{}, &OutboxAttempt{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate the outbox: %w", err)
+	}
+	return &outboxRepo{db: db}, nil
+}
+
+func (r *outboxRepo) Add(ctx context.Context, msg *biz.OutboxMessage) error {
+	now := time.Now()
+	m := &OutboxMessage{
+		Topic:              msg.Topic,
+		BookingID:          msg.BookingID,
+		WaitlistEntryID:    msg.WaitlistEntryID,
+		SeatCancellationID: msg.SeatCancellationID,
+		SeatChangeID:       msg.SeatChangeID,
+		TransferID:         msg.TransferID,
+		Status:             outboxPending,
+		NextAttemptAt:      now,
+		CreatedAt:          now,
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		return err
+	}
+	msg.ID = m.ID
+	return nil
+}
+
+func (r *outboxRepo) ListDue(ctx context.Context, limit int) ([]*biz.OutboxMessage, error) {
+	var msgs []OutboxMessage
+	if err := dbFrom(ctx, r.db).
+		Where("status = ? AND next_attempt_at <= ?", outboxPending, time.Now()).
+		Order("id").
+		Limit(limit).
+		Find(&msgs).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.OutboxMessage, 0, len(msgs))
+	for _, m := range msgs {
+		res = append(res, &biz.OutboxMessage{
+			ID:                 m.ID,
+			Topic:              m.Topic,
+			BookingID:          m.BookingID,
+			WaitlistEntryID:    m.WaitlistEntryID,
+			SeatCancellationID: m.SeatCancellationID,
+			SeatChangeID:       m.SeatChangeID,
+			TransferID:         m.TransferID,
+			Attempts:           m.Attempts,
+		})
+	}
+	return res, nil
+}
+
+func (r *outboxRepo) RecordAttempt(ctx context.Context, id uint64, attempt biz.OutboxAttempt) error {
+	return dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var m OutboxMessage
+		if err := tx.First(&m, id).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		m.Attempts++
+		switch {
+		case attempt.Delivered:
+			m.Status = outboxDelivered
+			m.DeliveredAt = &now
+		case attempt.NextAttemptAt.IsZero():
+			m.Status = outboxFailed
+		default:
+			m.NextAttemptAt = attempt.NextAttemptAt
+		}
+		if err := tx.Save(&m).Error; err != nil {
+			return err
+		}
+		return tx.Create(&OutboxAttempt{
+			MessageID: id,
+			Attempt:   m.Attempts,
+			Delivered: attempt.Delivered,
+			Detail:    attempt.Detail,
+			CreatedAt: now,
+		}).Error
+	})
+}
@@ -0,0 +1,26 @@
+package data
+
+import (
+	"context"
+	paymentv1 "paymentservice/api/paymentservice/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// ProvidePaymentClient creates a gRPC client to PaymentService
+func ProvidePaymentClient() (paymentv1.PaymentServiceClient, func(), error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("127.0.0.1:9003"), // PaymentService gRPC port
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		_ = conn.Close()
+	}
+
+	client := paymentv1.NewPaymentServiceClient(conn)
+	return client, cleanup, nil
+}
@@ -0,0 +1,176 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PromoCode DB model
+type PromoCode struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement"`
+	Code           string `gorm:"size:32;not null;uniqueIndex"`
+	Type           string `gorm:"size:20;not null"`
+	PercentOff     int32
+	AmountOffMinor int64
+	Currency       string         `gorm:"size:3"`
+	EventIDs       datatypes.JSON `gorm:"type:json"`
+	ValidFrom      *time.Time
+	ValidUntil     *time.Time
+	MaxUses        int32
+	MaxUsesPerUser int32
+	CreatedAt      time.Time
+}
+
+// PromoRedemption DB model. A booking uses at most one promo code.
+type PromoRedemption struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	PromoCodeID uint64 `gorm:"not null;index"`
+	BookingID   uint64 `gorm:"not null;uniqueIndex"`
+	UserID      uint64 `gorm:"not null;index"`
+	CreatedAt   time.Time
+}
+
+type promoRepo struct {
+	db *gorm.DB
+}
+
+func NewPromoRepo(db *gorm.DB) (biz.PromoRepo, error) {
+	if err := db.AutoMigrate(&PromoCode{}, &PromoRedemption{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate promo codes: %w", err)
+	}
+	return &promoRepo{db: db}, nil
+}
+
+func toPromoCode(m *PromoCode) (*biz.PromoCode, error) {
+	var eventIDs []uint64
+	if len(m.EventIDs) > 0 {
+		if err := json.Unmarshal(m.EventIDs, &eventIDs); err != nil {
+			return nil, err
+		}
+	}
+	p := &biz.PromoCode{
+		ID:             m.ID,
+		Code:           m.Code,
+		Type:           v1.PromoDiscountType(v1.PromoDiscountType_value[m.Type]),
+		PercentOff:     m.PercentOff,
+		AmountOff:      m.AmountOffMinor,
+		Currency:       m.Currency,
+		EventIDs:       eventIDs,
+		MaxUses:        m.MaxUses,
+		MaxUsesPerUser: m.MaxUsesPerUser,
+		CreatedAt:      m.CreatedAt,
+	}
+	if m.ValidFrom != nil {
+		p.ValidFrom = *m.ValidFrom
+	}
+	if m.ValidUntil != nil {
+		p.ValidUntil = *m.ValidUntil
+	}
+	return p, nil
+}
+
+func (r *promoRepo) Create(ctx context.Context, p *biz.PromoCode) (*biz.PromoCode, error) {
+	eventIDs, err := json.Marshal(p.EventIDs)
+	if err != nil {
+		return nil, err
+	}
+	m := &PromoCode{
+		Code:           p.Code,
+		Type:           p.Type.String(),
+		PercentOff:     p.PercentOff,
+		AmountOffMinor: p.AmountOff,
+		Currency:       p.Currency,
+		EventIDs:       eventIDs,
+		MaxUses:        p.MaxUses,
+		MaxUsesPerUser: p.MaxUsesPerUser,
+	}
+	if !p.ValidFrom.IsZero() {
+		m.ValidFrom = &p.ValidFrom
+	}
+	if !p.ValidUntil.IsZero() {
+		m.ValidUntil = &p.ValidUntil
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorPromoCodeAlreadyExists("promo code %s already exists", p.Code)
+		}
+		return nil, err
+	}
+	return toPromoCode(m)
+}
+
+func (r *promoRepo) GetByCode(ctx context.Context, code string) (*biz.PromoCode, error) {
+	var m PromoCode
+	if err := dbFrom(ctx, r.db).Where("code = ?", code).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorPromoCodeNotFound("no promo code %s", code)
+		}
+		return nil, err
+	}
+	p, err := toPromoCode(&m)
+	if err != nil {
+		return nil, err
+	}
+	uses, err := r.countUses(dbFrom(ctx, r.db), m.ID, 0)
+	if err != nil {
+		return nil, err
+	}
+	p.Uses = int32(uses)
+	return p, nil
+}
+
+// countUses counts the PENDING and CONFIRMED bookings using the promo code,
+// only userID's if it is set.
+func (r *promoRepo) countUses(db *gorm.DB, promoCodeID, userID uint64) (int64, error) {
+	q := db.Model(&PromoRedemption{}).
+		Joins("JOIN bookings ON bookings.id = promo_redemptions.booking_id").
+		Where("promo_redemptions.promo_code_id = ? AND bookings.status IN ?", promoCodeID,
+			[]string{v1.BookingStatus_PENDING.String(), v1.BookingStatus_CONFIRMED.String()})
+	if userID != 0 {
+		q = q.Where("promo_redemptions.user_id = ?", userID)
+	}
+	var n int64
+	err := q.Count(&n).Error
+	return n, err
+}
+
+func (r *promoRepo) Redeem(ctx context.Context, p *biz.PromoCode, bookingID, userID uint64) error {
+	return dbFrom(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Lock the code so concurrent bookings take its last uses one at a time
+		var m PromoCode
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&m, p.ID).Error; err != nil {
+			return err
+		}
+		if m.MaxUses > 0 {
+			uses, err := r.countUses(tx, m.ID, 0)
+			if err != nil {
+				return err
+			}
+			if uses >= int64(m.MaxUses) {
+				return v1.ErrorPromoCodeNotApplicable("promo code %s has been used up", m.Code).
+					WithMetadata(map[string]string{"reason": "used_up"})
+			}
+		}
+		if m.MaxUsesPerUser > 0 {
+			uses, err := r.countUses(tx, m.ID, userID)
+			if err != nil {
+				return err
+			}
+			if uses >= int64(m.MaxUsesPerUser) {
+				return v1.ErrorPromoCodeNotApplicable("user %d has used promo code %s %d times already", userID, m.Code, uses).
+					WithMetadata(map[string]string{"reason": "user_limit_reached"})
+			}
+		}
+		return tx.Create(&PromoRedemption{PromoCodeID: m.ID, BookingID: bookingID, UserID: userID}).Error
+	})
+}
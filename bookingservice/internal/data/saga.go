@@ -0,0 +1,87 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// ConfirmSaga DB model. A booking can have only one unfinished saga.
+type ConfirmSaga struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	BookingID uint64 `gorm:"not null;index;uniqueIndex:idx_confirm_sagas_unfinished,where:state NOT IN ('COMPLETED','COMPENSATED')"`
+	State     string `gorm:"size:32;not null;index"`
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type sagaRepo struct {
+	db *gorm.DB
+}
+
+func NewSagaRepo(db *gorm.DB) biz.SagaRepo {
+	db.AutoMigrate(&ConfirmSaga{})
+	return &sagaRepo{db: db}
+}
+
+func toSaga(m *ConfirmSaga) *biz.ConfirmSaga {
+	return &biz.ConfirmSaga{
+		ID:        m.ID,
+		BookingID: m.BookingID,
+		State:     m.State,
+		Error:     m.Error,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func (r *sagaRepo) Start(ctx context.Context, bookingID uint64) (*biz.ConfirmSaga, error) {
+	m := &ConfirmSaga{BookingID: bookingID, State: biz.SagaStarted}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorInvalidStatusTransition("booking %d is already being confirmed", bookingID)
+		}
+		return nil, err
+	}
+	return toSaga(m), nil
+}
+
+func (r *sagaRepo) Advance(ctx context.Context, id uint64, from, to, errMsg string) (bool, error) {
+	res := dbFrom(ctx, r.db).Model(&ConfirmSaga{}).
+		Where("id = ? AND state = ?", id, from).
+		Updates(map[string]interface{}{"state": to, "error": errMsg, "updated_at": time.Now()})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *sagaRepo) ListUnfinished(ctx context.Context, before time.Time, limit int) ([]*biz.ConfirmSaga, error) {
+	var sagas []ConfirmSaga
+	if err := dbFrom(ctx, r.db).
+		Where("state NOT IN ? AND updated_at < ?", []string{biz.SagaCompleted, biz.SagaCompensated}, before).
+		Order("updated_at").
+		Limit(limit).
+		Find(&sagas).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.ConfirmSaga, 0, len(sagas))
+	for i := range sagas {
+		res = append(res, toSaga(&sagas[i]))
+	}
+	return res, nil
+}
+
+func (r *sagaRepo) ListInFlightEvents(ctx context.Context) ([]uint64, error) {
+	var eventIDs []uint64
+	err := dbFrom(ctx, r.db).Model(&ConfirmSaga{}).
+		Joins("JOIN bookings ON bookings.id = confirm_sagas.booking_id").
+		Where("confirm_sagas.state NOT IN ?", []string{biz.SagaCompleted, biz.SagaCompensated}).
+		Distinct().
+		Pluck("bookings.event_id", &eventIDs).Error
+	return eventIDs, err
+}
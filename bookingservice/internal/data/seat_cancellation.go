@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bookingservice/internal/biz"
+	"eventservice/pkg/money"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// SeatCancellation DB model
+type SeatCancellation struct {
+	ID          uint64         `gorm:"primaryKey;autoIncrement"`
+	BookingID   uint64         `gorm:"not null;index"`
+	EventID     uint64         `gorm:"not null"`
+	SeatIDs     datatypes.JSON `gorm:"type:json"`
+	RefundMinor int64          `gorm:"not null;default:0"`
+	Currency    string         `gorm:"size:3;not null;default:'INR'"`
+	CreatedAt   time.Time
+}
+
+type seatCancellationRepo struct {
+	db *gorm.DB
+}
+
+func NewSeatCancellationRepo(db *gorm.DB, logger log.Logger) (biz.SeatCancellationRepo, error) {
+	if err := db.AutoMigrate(&SeatCancellation{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate seat cancellations: %w", err)
+	}
+	moved, err := money.MigrateMinorUnits(db, "seat_cancellations", "refund_amount", "refund_minor")
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill seat cancellations: %w", err)
+	}
+	if moved > 0 {
+		log.NewHelper(logger).Infof("Backfilled refund_minor for %d seat cancellations", moved)
+	}
+	return &seatCancellationRepo{db: db}, nil
+}
+
+func (r *seatCancellationRepo) Create(ctx context.Context, c *biz.SeatCancellation) (*biz.SeatCancellation, error) {
+	seatIDs, err := json.Marshal(c.SeatIDs)
+	if err != nil {
+		return nil, err
+	}
+	m := &SeatCancellation{
+		BookingID:   c.BookingID,
+		EventID:     c.EventID,
+		SeatIDs:     seatIDs,
+		RefundMinor: c.RefundAmount,
+		Currency:    c.Currency,
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		return nil, err
+	}
+	c.ID = m.ID
+	c.CreatedAt = m.CreatedAt
+	return c, nil
+}
+
+func (r *seatCancellationRepo) Get(ctx context.Context, id uint64) (*biz.SeatCancellation, error) {
+	var m SeatCancellation
+	if err := dbFrom(ctx, r.db).First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	var seatIDs []string
+	if err := json.Unmarshal(m.SeatIDs, &seatIDs); err != nil {
+		return nil, err
+	}
+	return &biz.SeatCancellation{
+		ID:           m.ID,
+		BookingID:    m.BookingID,
+		EventID:      m.EventID,
+		SeatIDs:      seatIDs,
+		RefundAmount: m.RefundMinor,
+		Currency:     m.Currency,
+		CreatedAt:    m.CreatedAt,
+	}, nil
+}
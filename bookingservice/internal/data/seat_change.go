@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"bookingservice/internal/biz"
+	"eventservice/pkg/money"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// SeatChange DB model
+type SeatChange struct {
+	ID                   uint64         `gorm:"primaryKey;autoIncrement"`
+	BookingID            uint64         `gorm:"not null;index"`
+	EventID              uint64         `gorm:"not null"`
+	OldSeatIDs           datatypes.JSON `gorm:"type:json"`
+	NewSeatIDs           datatypes.JSON `gorm:"type:json"`
+	PriceDifferenceMinor int64          `gorm:"not null;default:0"`
+	Currency             string         `gorm:"size:3;not null;default:'INR'"`
+	CreatedAt            time.Time
+}
+
+type seatChangeRepo struct {
+	db *gorm.DB
+}
+
+func NewSeatChangeRepo(db *gorm.DB, logger log.Logger) (biz.SeatChangeRepo, error) {
+	if err := db.AutoMigrate(&SeatChange{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate seat changes: %w", err)
+	}
+	moved, err := money.MigrateMinorUnits(db, "seat_changes", "price_difference", "price_difference_minor")
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill seat changes: %w", err)
+	}
+	if moved > 0 {
+		log.NewHelper(logger).Infof("Backfilled price_difference_minor for %d seat changes", moved)
+	}
+	return &seatChangeRepo{db: db}, nil
+}
+
+func (r *seatChangeRepo) Create(ctx context.Context, c *biz.SeatChange) (*biz.SeatChange, error) {
+	oldSeatIDs, err := json.Marshal(c.OldSeatIDs)
+	if err != nil {
+		return nil, err
+	}
+	newSeatIDs, err := json.Marshal(c.NewSeatIDs)
+	if err != nil {
+		return nil, err
+	}
+	m := &SeatChange{
+		BookingID:            c.BookingID,
+		EventID:              c.EventID,
+		OldSeatIDs:           oldSeatIDs,
+		NewSeatIDs:           newSeatIDs,
+		PriceDifferenceMinor: c.PriceDifference,
+		Currency:             c.Currency,
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		return nil, err
+	}
+	c.ID = m.ID
+	c.CreatedAt = m.CreatedAt
+	return c, nil
+}
+
+func (r *seatChangeRepo) Get(ctx context.Context, id uint64) (*biz.SeatChange, error) {
+	var m SeatChange
+	if err := dbFrom(ctx, r.db).First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	var oldSeatIDs, newSeatIDs []string
+	if err := json.Unmarshal(m.OldSeatIDs, &oldSeatIDs); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(m.NewSeatIDs, &newSeatIDs); err != nil {
+		return nil, err
+	}
+	return &biz.SeatChange{
+		ID:              m.ID,
+		BookingID:       m.BookingID,
+		EventID:         m.EventID,
+		OldSeatIDs:      oldSeatIDs,
+		NewSeatIDs:      newSeatIDs,
+		PriceDifference: m.PriceDifferenceMinor,
+		Currency:        m.Currency,
+		CreatedAt:       m.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+	"bookingservice/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+// SeatFeed publishes seat updates on a Redis channel per event, so every
+// replica's watchers see the writes of all of them. Without Redis the
+// updates go through an in-process bus instead.
+type SeatFeed struct {
+	redis *redis.Client
+	local *localSeatBus
+	log   *log.Helper
+}
+
+// NewSeatFeed returns the feed the booking repo publishes to and watchers
+// subscribe to.
+func NewSeatFeed(c *conf.Data, redis *redis.Client, logger log.Logger) *SeatFeed {
+	f := &SeatFeed{redis: redis, log: log.NewHelper(logger)}
+	if inProcess(c) {
+		f.redis, f.local = nil, newLocalSeatBus()
+	}
+	return f
+}
+
+func seatFeedChannel(eventID uint64) string {
+	return fmt.Sprintf("booking:seats:%d", eventID)
+}
+
+// seatUpdateMessage is the wire form of a biz.SeatUpdate on the channel.
+type seatUpdateMessage struct {
+	EventID   uint64    `json:"event_id"`
+	Kind      string    `json:"kind"`
+	SeatIDs   []string  `json:"seat_ids"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	At        time.Time `json:"at"`
+}
+
+// publish sends an update once the transaction ctx carries commits. The
+// feed is best effort: watchers resync from a snapshot when they
+// reconnect, so a lost update is logged rather than failing the write.
+func (f *SeatFeed) publish(ctx context.Context, eventID uint64, kind v1.SeatUpdateKind, seatIDs []string, expiresAt time.Time) {
+	if len(seatIDs) == 0 {
+		return
+	}
+	msg, err := json.Marshal(seatUpdateMessage{
+		EventID:   eventID,
+		Kind:      kind.String(),
+		SeatIDs:   seatIDs,
+		ExpiresAt: expiresAt,
+		At:        time.Now(),
+	})
+	if err != nil {
+		f.log.Errorf("Failed to encode seat update for event %d: %v", eventID, err)
+		return
+	}
+	afterCommit(ctx, func() {
+		if f.local != nil {
+			f.local.publish(eventID, string(msg))
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second)
+		defer cancel()
+		if err := f.redis.Publish(ctx, seatFeedChannel(eventID), msg).Err(); err != nil {
+			f.log.Errorf("Failed to publish seat update for event %d: %v", eventID, err)
+		}
+	})
+}
+
+func (f *SeatFeed) Subscribe(ctx context.Context, eventID uint64) (<-chan *biz.SeatUpdate, error) {
+	msgs, stop, err := f.subscribe(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan *biz.SeatUpdate)
+	go func() {
+		defer close(updates)
+		defer stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-msgs:
+				if !ok {
+					return
+				}
+				var u seatUpdateMessage
+				if err := json.Unmarshal([]byte(m), &u); err != nil {
+					f.log.Errorf("Dropping malformed seat update on %s: %v", seatFeedChannel(eventID), err)
+					continue
+				}
+				select {
+				case updates <- &biz.SeatUpdate{
+					EventID:   u.EventID,
+					Kind:      v1.SeatUpdateKind(v1.SeatUpdateKind_value[u.Kind]),
+					SeatIDs:   u.SeatIDs,
+					ExpiresAt: u.ExpiresAt,
+					At:        u.At,
+				}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return updates, nil
+}
+
+// subscribe returns the raw messages on the event's channel and a func
+// that ends the subscription.
+func (f *SeatFeed) subscribe(ctx context.Context, eventID uint64) (<-chan string, func(), error) {
+	if f.local != nil {
+		msgs, stop := f.local.subscribe(eventID)
+		return msgs, stop, nil
+	}
+	sub := f.redis.Subscribe(ctx, seatFeedChannel(eventID))
+	// Wait for the subscription to be confirmed, so nothing published after
+	// Subscribe returns is missed
+	if _, err := sub.Receive(ctx); err != nil {
+		sub.Close()
+		return nil, nil, err
+	}
+	msgs := make(chan string)
+	go func() {
+		defer close(msgs)
+		for m := range sub.Channel() {
+			select {
+			case msgs <- m.Payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return msgs, func() { sub.Close() }, nil
+}
+
+// localSeatBus hands seat updates to the subscribers in this process. Like
+// Redis pub/sub it is best effort: a subscriber that falls behind misses
+// updates rather than holding up the writer.
+type localSeatBus struct {
+	mu   sync.Mutex
+	subs map[uint64]map[chan string]struct{}
+}
+
+func newLocalSeatBus() *localSeatBus {
+	return &localSeatBus{subs: make(map[uint64]map[chan string]struct{})}
+}
+
+func (b *localSeatBus) publish(eventID uint64, msg string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[eventID] {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+func (b *localSeatBus) subscribe(eventID uint64) (<-chan string, func()) {
+	ch := make(chan string, 64)
+	b.mu.Lock()
+	if b.subs[eventID] == nil {
+		b.subs[eventID] = make(map[chan string]struct{})
+	}
+	b.subs[eventID][ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[eventID], ch)
+		if len(b.subs[eventID]) == 0 {
+			delete(b.subs, eventID)
+		}
+	}
+}
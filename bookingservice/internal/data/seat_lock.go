@@ -0,0 +1,70 @@
+package data
+
+import (
+	"context"
+	"fmt"
+
+	"bookingservice/internal/biz"
+	"bookingservice/internal/conf"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Seat lock backends, chosen with data.seat_lock.backend.
+const (
+	// SeatLockRedis keeps holds in Redis and is the default.
+	SeatLockRedis = "redis"
+	// SeatLockMemory keeps holds in this process. With it everything else
+	// that would go to Redis, the leases, the seat feed and the waiting
+	// rooms, stays in process as well, so Redis is not needed at all; it
+	// only suits a single replica.
+	SeatLockMemory = "memory"
+)
+
+// seatLockBackend returns the configured backend, failing on unknown ones.
+func seatLockBackend(c *conf.Data) (string, error) {
+	switch b := c.GetSeatLock().GetBackend(); b {
+	case "", SeatLockRedis:
+		return SeatLockRedis, nil
+	case SeatLockMemory:
+		return SeatLockMemory, nil
+	default:
+		return "", fmt.Errorf("unknown seat lock backend %q", b)
+	}
+}
+
+// inProcess reports whether c runs without Redis.
+func inProcess(c *conf.Data) bool {
+	b, _ := seatLockBackend(c)
+	return b == SeatLockMemory
+}
+
+// SeatLocker stores seat holds. Every call applies to all of seatIDs or to
+// none of them. Seats the owner already holds under owner.Token count as the
+// owner's, not as conflicts; with no token, every held seat is a conflict.
+type SeatLocker interface {
+	// Hold locks seatIDs under token, taking over the owner's holds on them
+	// and keeping their start time and extension count. It returns
+	// biz.ErrHoldLimitReached if that start time is MaxHold ago.
+	Hold(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, token string, policy biz.HoldPolicy) (*biz.HoldResult, error)
+	// Extend pushes back the expiry of the owner's hold on every one of
+	// seatIDs, or returns biz.ErrHoldLimitReached.
+	Extend(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, policy biz.HoldPolicy) (*biz.HoldResult, error)
+	// Release unlocks seatIDs if none is held by someone else, and returns
+	// the ones that are.
+	Release(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold) ([]string, error)
+	// List returns the event's unexpired holds.
+	List(ctx context.Context, eventID uint64) ([]*biz.LockedSeat, error)
+}
+
+// NewSeatLocker returns the seat lock backend chosen in c.
+func NewSeatLocker(c *conf.Data, redis *redis.Client) (SeatLocker, error) {
+	backend, err := seatLockBackend(c)
+	if err != nil {
+		return nil, err
+	}
+	if backend == SeatLockMemory {
+		return newMemorySeatLocker(), nil
+	}
+	return &redisSeatLocker{redis: redis}, nil
+}
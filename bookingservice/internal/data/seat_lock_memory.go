@@ -0,0 +1,175 @@
+package data
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bookingservice/internal/biz"
+)
+
+// memoryHold is one seat's hold in a memorySeatLocker.
+type memoryHold struct {
+	owner      biz.SeatHold
+	startedAt  time.Time
+	extensions int32
+	expiresAt  time.Time
+}
+
+// memorySeatLocker keeps seat holds in a map, following the same rules as
+// the Redis scripts. Expired holds are treated as gone and swept out as
+// events are touched.
+type memorySeatLocker struct {
+	mu     sync.Mutex
+	events map[uint64]map[string]*memoryHold
+}
+
+func newMemorySeatLocker() *memorySeatLocker {
+	return &memorySeatLocker{events: make(map[uint64]map[string]*memoryHold)}
+}
+
+// seats returns the event's live holds, dropping expired ones. Callers
+// hold l.mu.
+func (l *memorySeatLocker) seats(eventID uint64, now time.Time) map[string]*memoryHold {
+	holds, ok := l.events[eventID]
+	if !ok {
+		holds = make(map[string]*memoryHold)
+		l.events[eventID] = holds
+	}
+	for seatID, h := range holds {
+		if !now.Before(h.expiresAt) {
+			delete(holds, seatID)
+		}
+	}
+	return holds
+}
+
+// scanHolds checks every seat against owner. It returns the seats held by
+// someone else (and the free ones, if requireHeld is set), and the earliest
+// start and highest extension count of the owner's holds.
+func scanHolds(holds map[string]*memoryHold, seatIDs []string, owner biz.SeatHold, requireHeld bool) ([]string, time.Time, int32) {
+	var (
+		conflicts []string
+		started   time.Time
+		ext       int32
+	)
+	for _, seatID := range seatIDs {
+		h, ok := holds[seatID]
+		if !ok {
+			if requireHeld {
+				conflicts = append(conflicts, seatID)
+			}
+			continue
+		}
+		if !h.owner.HeldBy(owner) {
+			conflicts = append(conflicts, seatID)
+			continue
+		}
+		if started.IsZero() || h.startedAt.Before(started) {
+			started = h.startedAt
+		}
+		if h.extensions > ext {
+			ext = h.extensions
+		}
+	}
+	return conflicts, started, ext
+}
+
+// writeHolds stores h on every seat.
+func writeHolds(holds map[string]*memoryHold, seatIDs []string, h memoryHold) {
+	for _, seatID := range seatIDs {
+		hold := h
+		holds[seatID] = &hold
+	}
+}
+
+func (l *memorySeatLocker) Hold(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, token string, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	holds := l.seats(eventID, now)
+	conflicts, started, ext := scanHolds(holds, seatIDs, owner, false)
+	if len(conflicts) > 0 {
+		return &biz.HoldResult{Conflicts: conflicts}, nil
+	}
+	if started.IsZero() {
+		started = now
+	}
+	expiry := minTime(now.Add(policy.TTL), started.Add(policy.MaxHold))
+	if !expiry.After(now) {
+		return nil, biz.ErrHoldLimitReached
+	}
+	writeHolds(holds, seatIDs, memoryHold{
+		owner:      biz.SeatHold{UserID: owner.UserID, Token: token},
+		startedAt:  started,
+		extensions: ext,
+		expiresAt:  expiry,
+	})
+	return &biz.HoldResult{Token: token, ExpiresAt: expiry, Extensions: ext}, nil
+}
+
+func (l *memorySeatLocker) Extend(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	holds := l.seats(eventID, now)
+	conflicts, started, ext := scanHolds(holds, seatIDs, owner, true)
+	if len(conflicts) > 0 {
+		return &biz.HoldResult{Conflicts: conflicts}, nil
+	}
+	current := holds[seatIDs[0]].expiresAt
+	expiry := minTime(now.Add(policy.TTL), started.Add(policy.MaxHold))
+	if ext >= policy.MaxExtensions || !expiry.After(current) {
+		return nil, biz.ErrHoldLimitReached
+	}
+	writeHolds(holds, seatIDs, memoryHold{
+		owner:      owner,
+		startedAt:  started,
+		extensions: ext + 1,
+		expiresAt:  expiry,
+	})
+	return &biz.HoldResult{Token: owner.Token, ExpiresAt: expiry, Extensions: ext + 1}, nil
+}
+
+func (l *memorySeatLocker) Release(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold) ([]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	holds := l.seats(eventID, time.Now())
+	conflicts, _, _ := scanHolds(holds, seatIDs, owner, false)
+	if len(conflicts) > 0 {
+		return conflicts, nil
+	}
+	for _, seatID := range seatIDs {
+		delete(holds, seatID)
+	}
+	return []string{}, nil
+}
+
+func (l *memorySeatLocker) List(ctx context.Context, eventID uint64) ([]*biz.LockedSeat, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	holds := l.seats(eventID, time.Now())
+	seats := make([]*biz.LockedSeat, 0, len(holds))
+	for seatID, h := range holds {
+		seats = append(seats, &biz.LockedSeat{SeatID: seatID, Owner: h.owner, ExpiresAt: h.expiresAt})
+	}
+	if len(holds) == 0 {
+		delete(l.events, eventID)
+	}
+	// Same order as the Redis registry: soonest expiry first
+	sort.Slice(seats, func(i, j int) bool {
+		if !seats[i].ExpiresAt.Equal(seats[j].ExpiresAt) {
+			return seats[i].ExpiresAt.Before(seats[j].ExpiresAt)
+		}
+		return seats[i].SeatID < seats[j].SeatID
+	})
+	return seats, nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
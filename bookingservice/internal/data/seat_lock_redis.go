@@ -0,0 +1,269 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"bookingservice/internal/biz"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Seat holds live in two places: one lock key per seat, whose TTL is the
+// hold expiry and whose value is
+//
+//	<user_id>:<hold_token>:<started_at_ms>:<extensions>
+//
+// and a per-event sorted set (the hold registry) of seat IDs scored by
+// expiry in unix ms, so an event's holds can be listed without scanning the
+// keyspace. The scripts below take the registry as KEYS[1] and the seat lock
+// keys as KEYS[2..].
+const seatHoldLua = `
+local function parse(v)
+	local user, token, started, ext = string.match(v, "^([^:]*):([^:]*):(%d+):(%d+)$")
+	if user then
+		return user, token, tonumber(started), tonumber(ext)
+	end
+	-- values written before hold metadata existed
+	user, token = string.match(v, "^([^:]*):(.*)$")
+	if user then
+		return user, token, nil, 0
+	end
+	return v, "", nil, 0
+end
+
+-- scan checks every seat against the caller. It returns the positions of
+-- seats held by someone else (and of free seats, if require_held is set),
+-- and the earliest start and highest extension count of the caller's holds.
+local function scan(user, token, require_held)
+	local res, started, ext = {}, nil, 0
+	for i = 2, #KEYS do
+		local v = redis.call("GET", KEYS[i])
+		if v then
+			local u, t, s, e = parse(v)
+			if u ~= user or token == "" or t ~= token then
+				table.insert(res, i - 1)
+			else
+				if s and (not started or s < started) then
+					started = s
+				end
+				if e > ext then
+					ext = e
+				end
+			end
+		elseif require_held then
+			table.insert(res, i - 1)
+		end
+	end
+	return res, started, ext
+end
+
+-- write stores the hold on every seat and in the registry; seat IDs start
+-- at ARGV[first_seat].
+local function write(user, token, started, ext, now, expiry, first_seat)
+	local value = user .. ":" .. token .. ":" .. started .. ":" .. ext
+	for i = 2, #KEYS do
+		redis.call("SET", KEYS[i], value, "PX", expiry - now)
+		redis.call("ZADD", KEYS[1], expiry, ARGV[first_seat + i - 2])
+	end
+	local last = redis.call("ZRANGE", KEYS[1], -1, -1, "WITHSCORES")
+	redis.call("PEXPIREAT", KEYS[1], last[2])
+end
+`
+
+// holdSeatsScript takes every seat lock or none of them. Seats already held
+// by ARGV[1] under token ARGV[2] are taken over; without a token every held
+// seat is a conflict. ARGV[3] is the
+// token to write, ARGV[4] the current unix ms, ARGV[5] the hold TTL,
+// ARGV[6] the maximum hold length and ARGV[7..] the seat IDs.
+//
+// It returns {0, expiry, extensions} on success, {1, 0, 0, positions...}
+// when seats are held by someone else, and {2, 0, extensions} when the
+// caller's hold has reached its maximum length.
+var holdSeatsScript = redis.NewScript(seatHoldLua + `
+local now, ttl, max_hold = tonumber(ARGV[4]), tonumber(ARGV[5]), tonumber(ARGV[6])
+local res, started, ext = scan(ARGV[1], ARGV[2], false)
+if #res > 0 then
+	return {1, 0, 0, unpack(res)}
+end
+started = started or now
+local expiry = math.min(now + ttl, started + max_hold)
+if expiry <= now then
+	return {2, 0, ext}
+end
+write(ARGV[1], ARGV[3], started, ext, now, expiry, 7)
+return {0, expiry, ext}
+`)
+
+// extendSeatsScript pushes back the expiry of a hold that ARGV[1] holds on
+// every seat under token ARGV[2]. ARGV[3] is the current unix ms, ARGV[4]
+// the extension length, ARGV[5] the maximum hold length, ARGV[6] the
+// maximum number of extensions and ARGV[7..] the seat IDs. It returns the
+// same shapes as holdSeatsScript.
+var extendSeatsScript = redis.NewScript(seatHoldLua + `
+local now, ttl, max_hold, max_ext = tonumber(ARGV[3]), tonumber(ARGV[4]), tonumber(ARGV[5]), tonumber(ARGV[6])
+local res, started, ext = scan(ARGV[1], ARGV[2], true)
+if #res > 0 then
+	return {1, 0, 0, unpack(res)}
+end
+started = started or now
+local current = now + redis.call("PTTL", KEYS[2])
+local expiry = math.min(now + ttl, started + max_hold)
+if ext >= max_ext or expiry <= current then
+	return {2, 0, ext}
+end
+write(ARGV[1], ARGV[2], started, ext + 1, now, expiry, 7)
+return {0, expiry, ext + 1}
+`)
+
+// releaseSeatsScript deletes every seat lock if all of them belong to
+// ARGV[1] under token ARGV[2], and none of them otherwise.
+// ARGV[3..] are the seat IDs.
+var releaseSeatsScript = redis.NewScript(seatHoldLua + `
+local res = scan(ARGV[1], ARGV[2], false)
+if #res > 0 then
+	return res
+end
+for i = 2, #KEYS do
+	redis.call("DEL", KEYS[i])
+	redis.call("ZREM", KEYS[1], ARGV[i + 1])
+end
+return res
+`)
+
+func seatLockKey(eventID uint64, seatID string) string {
+	return fmt.Sprintf("booking:lock:%d:%s", eventID, seatID)
+}
+
+func seatHoldRegistryKey(eventID uint64) string {
+	return fmt.Sprintf("booking:holds:%d", eventID)
+}
+
+// seatScriptKeys returns the registry key followed by one lock key per seat.
+func seatScriptKeys(eventID uint64, seatIDs []string) []string {
+	keys := make([]string, 0, len(seatIDs)+1)
+	keys = append(keys, seatHoldRegistryKey(eventID))
+	for _, seatID := range seatIDs {
+		keys = append(keys, seatLockKey(eventID, seatID))
+	}
+	return keys
+}
+
+// pickSeats maps the 1-based positions returned by the seat scripts back to
+// seat IDs.
+func pickSeats(seatIDs []string, positions []int64) []string {
+	seats := make([]string, 0, len(positions))
+	for _, pos := range positions {
+		seats = append(seats, seatIDs[pos-1])
+	}
+	return seats
+}
+
+// holdResult decodes the reply of holdSeatsScript and extendSeatsScript.
+func holdResult(reply []int64, token string, seatIDs []string) (*biz.HoldResult, error) {
+	switch reply[0] {
+	case 1:
+		return &biz.HoldResult{Conflicts: pickSeats(seatIDs, reply[3:])}, nil
+	case 2:
+		return nil, biz.ErrHoldLimitReached
+	}
+	return &biz.HoldResult{
+		Token:      token,
+		ExpiresAt:  time.UnixMilli(reply[1]),
+		Extensions: int32(reply[2]),
+	}, nil
+}
+
+// parseSeatOwner is the Go side of the parse() Lua helper.
+func parseSeatOwner(v string) biz.SeatHold {
+	parts := strings.SplitN(v, ":", 3)
+	userID, _ := strconv.ParseUint(parts[0], 10, 64)
+	owner := biz.SeatHold{UserID: userID}
+	if len(parts) > 1 {
+		owner.Token = parts[1]
+	}
+	return owner
+}
+
+func seatArgs(args []interface{}, seatIDs []string) []interface{} {
+	for _, seatID := range seatIDs {
+		args = append(args, seatID)
+	}
+	return args
+}
+
+// redisSeatLocker keeps seat holds in Redis, so every replica sees them.
+type redisSeatLocker struct {
+	redis *redis.Client
+}
+
+func (l *redisSeatLocker) Hold(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, token string, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	args := seatArgs([]interface{}{owner.UserID, owner.Token, token, time.Now().UnixMilli(),
+		policy.TTL.Milliseconds(), policy.MaxHold.Milliseconds()}, seatIDs)
+	reply, err := holdSeatsScript.Run(ctx, l.redis, seatScriptKeys(eventID, seatIDs), args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	return holdResult(reply, token, seatIDs)
+}
+
+func (l *redisSeatLocker) Extend(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold, policy biz.HoldPolicy) (*biz.HoldResult, error) {
+	args := seatArgs([]interface{}{owner.UserID, owner.Token, time.Now().UnixMilli(),
+		policy.TTL.Milliseconds(), policy.MaxHold.Milliseconds(), policy.MaxExtensions}, seatIDs)
+	reply, err := extendSeatsScript.Run(ctx, l.redis, seatScriptKeys(eventID, seatIDs), args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	return holdResult(reply, owner.Token, seatIDs)
+}
+
+func (l *redisSeatLocker) Release(ctx context.Context, eventID uint64, seatIDs []string, owner biz.SeatHold) ([]string, error) {
+	args := seatArgs([]interface{}{owner.UserID, owner.Token}, seatIDs)
+	positions, err := releaseSeatsScript.Run(ctx, l.redis, seatScriptKeys(eventID, seatIDs), args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	return pickSeats(seatIDs, positions), nil
+}
+
+// List reads the event's hold registry, dropping entries whose expiry has
+// passed, and looks up the owner of each remaining seat.
+func (l *redisSeatLocker) List(ctx context.Context, eventID uint64) ([]*biz.LockedSeat, error) {
+	registry := seatHoldRegistryKey(eventID)
+	now := time.Now().UnixMilli()
+	if err := l.redis.ZRemRangeByScore(ctx, registry, "-inf", strconv.FormatInt(now, 10)).Err(); err != nil {
+		return nil, err
+	}
+	entries, err := l.redis.ZRangeWithScores(ctx, registry, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return []*biz.LockedSeat{}, nil
+	}
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, seatLockKey(eventID, e.Member.(string)))
+	}
+	values, err := l.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	seats := make([]*biz.LockedSeat, 0, len(entries))
+	for i, e := range entries {
+		v, ok := values[i].(string)
+		if !ok {
+			// lock key is gone; the registry entry is stale
+			continue
+		}
+		seats = append(seats, &biz.LockedSeat{
+			SeatID:    e.Member.(string),
+			Owner:     parseSeatOwner(v),
+			ExpiresAt: time.UnixMilli(int64(e.Score)),
+		})
+	}
+	return seats, nil
+}
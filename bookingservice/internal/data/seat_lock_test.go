@@ -0,0 +1,324 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"bookingservice/internal/biz"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// lockerUnderTest is a fresh SeatLocker and a way to let time pass for it.
+type lockerUnderTest struct {
+	locker SeatLocker
+	elapse func(d time.Duration)
+}
+
+// lockersUnderTest runs the seat lock tests against the memory locker and
+// against the Redis scripts on miniredis, whose key expiry only moves when
+// it is fast-forwarded.
+var lockersUnderTest = map[string]func(t *testing.T) lockerUnderTest{
+	"memory": func(t *testing.T) lockerUnderTest {
+		return lockerUnderTest{locker: newMemorySeatLocker(), elapse: time.Sleep}
+	},
+	"redis": func(t *testing.T) lockerUnderTest {
+		mr := miniredis.RunT(t)
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { client.Close() })
+		return lockerUnderTest{locker: &redisSeatLocker{redis: client}, elapse: func(d time.Duration) {
+			time.Sleep(d)
+			mr.FastForward(d)
+		}}
+	},
+}
+
+var testHoldPolicy = biz.HoldPolicy{TTL: 2 * time.Minute, MaxHold: 10 * time.Minute, MaxExtensions: 2}
+
+// seatLockStep is one call to a SeatLocker and what it should return.
+type seatLockStep struct {
+	op            string // "hold", "extend" or "release"
+	seats         []string
+	owner         biz.SeatHold
+	token         string          // token a hold writes
+	policy        *biz.HoldPolicy // testHoldPolicy if nil
+	elapse        time.Duration   // time to let pass before the call
+	wantConflicts []string
+	wantErr       error
+	wantExt       int32
+}
+
+func TestSeatLocker(t *testing.T) {
+	u1a := biz.SeatHold{UserID: 1, Token: "a"}
+	u1b := biz.SeatHold{UserID: 1, Token: "b"}
+	u2 := biz.SeatHold{UserID: 2, Token: "c"}
+	short := &biz.HoldPolicy{TTL: 50 * time.Millisecond, MaxHold: time.Minute, MaxExtensions: 2}
+	capped := &biz.HoldPolicy{TTL: 2 * time.Minute, MaxHold: 2 * time.Minute, MaxExtensions: 2}
+
+	tests := []struct {
+		name     string
+		steps    []seatLockStep
+		wantHeld map[string]uint64 // seat to holder once the steps ran
+	}{
+		{
+			name:     "hold free seats",
+			steps:    []seatLockStep{{op: "hold", seats: []string{"A1", "A2"}, token: "a"}},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 1},
+		},
+		{
+			name: "all or nothing",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "hold", seats: []string{"A1", "A2"}, owner: u2, token: "c", wantConflicts: []string{"A1"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "take over own hold under a new token",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "hold", seats: []string{"A1", "A2"}, owner: u1a, token: "b"},
+				{op: "release", seats: []string{"A1", "A2"}, owner: u1a, wantConflicts: []string{"A1", "A2"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 1},
+		},
+		{
+			name: "same user without a token",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "hold", seats: []string{"A1"}, owner: biz.SeatHold{UserID: 1}, token: "b", wantConflicts: []string{"A1"}},
+				{op: "release", seats: []string{"A1"}, owner: biz.SeatHold{UserID: 1}, wantConflicts: []string{"A1"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "same user with another token",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "hold", seats: []string{"A1"}, owner: u1b, token: "b", wantConflicts: []string{"A1"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "release own hold",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1", "A2"}, token: "a"},
+				{op: "release", seats: []string{"A1", "A2"}, owner: u1a},
+			},
+			wantHeld: map[string]uint64{},
+		},
+		{
+			name: "release someone else's hold",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1", "A2"}, token: "a"},
+				{op: "release", seats: []string{"A1", "A2"}, owner: u2, wantConflicts: []string{"A1", "A2"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 1},
+		},
+		{
+			name: "release partly held by someone else",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "hold", seats: []string{"A2"}, owner: u2, token: "c"},
+				{op: "release", seats: []string{"A1", "A2"}, owner: u1a, wantConflicts: []string{"A2"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 2},
+		},
+		{
+			name:     "release a free seat",
+			steps:    []seatLockStep{{op: "release", seats: []string{"A1"}, owner: u1a}},
+			wantHeld: map[string]uint64{},
+		},
+		{
+			name: "extend until the extensions run out",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1", "A2"}, token: "a"},
+				{op: "extend", seats: []string{"A1", "A2"}, owner: u1a, elapse: 5 * time.Millisecond, wantExt: 1},
+				{op: "extend", seats: []string{"A1", "A2"}, owner: u1a, elapse: 5 * time.Millisecond, wantExt: 2},
+				{op: "extend", seats: []string{"A1", "A2"}, owner: u1a, elapse: 5 * time.Millisecond, wantErr: biz.ErrHoldLimitReached},
+			},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 1},
+		},
+		{
+			name: "extend past the maximum hold",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a", policy: capped},
+				{op: "extend", seats: []string{"A1"}, owner: u1a, policy: capped, elapse: 5 * time.Millisecond, wantErr: biz.ErrHoldLimitReached},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "extend a free seat",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "extend", seats: []string{"A1", "A2"}, owner: u1a, wantConflicts: []string{"A2"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "extend someone else's hold",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "extend", seats: []string{"A1"}, owner: u2, wantConflicts: []string{"A1"}},
+			},
+			wantHeld: map[string]uint64{"A1": 1},
+		},
+		{
+			name: "take over keeps the extension count",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a"},
+				{op: "extend", seats: []string{"A1"}, owner: u1a, elapse: 5 * time.Millisecond, wantExt: 1},
+				{op: "hold", seats: []string{"A1", "A2"}, owner: u1a, token: "b", wantExt: 1},
+			},
+			wantHeld: map[string]uint64{"A1": 1, "A2": 1},
+		},
+		{
+			name: "expired hold is free",
+			steps: []seatLockStep{
+				{op: "hold", seats: []string{"A1"}, token: "a", policy: short},
+				{op: "hold", seats: []string{"A1"}, owner: u2, token: "c", elapse: 100 * time.Millisecond},
+			},
+			wantHeld: map[string]uint64{"A1": 2},
+		},
+	}
+	for backendName, newBackend := range lockersUnderTest {
+		for _, tt := range tests {
+			t.Run(backendName+"/"+tt.name, func(t *testing.T) {
+				b := newBackend(t)
+				ctx := context.Background()
+				for i, step := range tt.steps {
+					if step.elapse > 0 {
+						b.elapse(step.elapse)
+					}
+					policy := testHoldPolicy
+					if step.policy != nil {
+						policy = *step.policy
+					}
+					var (
+						res       *biz.HoldResult
+						conflicts []string
+						err       error
+					)
+					switch step.op {
+					case "hold":
+						if step.owner.UserID == 0 {
+							step.owner.UserID = 1
+						}
+						res, err = b.locker.Hold(ctx, 1, step.seats, step.owner, step.token, policy)
+					case "extend":
+						res, err = b.locker.Extend(ctx, 1, step.seats, step.owner, policy)
+					case "release":
+						conflicts, err = b.locker.Release(ctx, 1, step.seats, step.owner)
+					}
+					if !errors.Is(err, step.wantErr) {
+						t.Fatalf("step %d %s: err = %v, want %v", i, step.op, err, step.wantErr)
+					}
+					if err != nil {
+						continue
+					}
+					if res != nil {
+						conflicts = res.Conflicts
+						if len(conflicts) == 0 && res.Extensions != step.wantExt {
+							t.Errorf("step %d %s: extensions = %d, want %d", i, step.op, res.Extensions, step.wantExt)
+						}
+					}
+					if len(conflicts) != 0 || len(step.wantConflicts) != 0 {
+						if !reflect.DeepEqual(conflicts, step.wantConflicts) {
+							t.Errorf("step %d %s: conflicts = %v, want %v", i, step.op, conflicts, step.wantConflicts)
+						}
+					}
+				}
+
+				seats, err := b.locker.List(ctx, 1)
+				if err != nil {
+					t.Fatalf("List: %v", err)
+				}
+				held := make(map[string]uint64, len(seats))
+				for _, s := range seats {
+					held[s.SeatID] = s.Owner.UserID
+				}
+				if !reflect.DeepEqual(held, tt.wantHeld) {
+					t.Errorf("held = %v, want %v", held, tt.wantHeld)
+				}
+			})
+		}
+	}
+}
+
+func TestSeatLockerListsSoonestExpiryFirst(t *testing.T) {
+	for backendName, newBackend := range lockersUnderTest {
+		t.Run(backendName, func(t *testing.T) {
+			b := newBackend(t)
+			ctx := context.Background()
+			later := biz.HoldPolicy{TTL: 5 * time.Minute, MaxHold: 10 * time.Minute}
+			if _, err := b.locker.Hold(ctx, 1, []string{"A1"}, biz.SeatHold{UserID: 1}, "a", later); err != nil {
+				t.Fatalf("Hold: %v", err)
+			}
+			if _, err := b.locker.Hold(ctx, 1, []string{"B1", "A2"}, biz.SeatHold{UserID: 2}, "b", testHoldPolicy); err != nil {
+				t.Fatalf("Hold: %v", err)
+			}
+			seats, err := b.locker.List(ctx, 1)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			var order []string
+			for _, s := range seats {
+				order = append(order, s.SeatID)
+			}
+			if want := []string{"A2", "B1", "A1"}; !reflect.DeepEqual(order, want) {
+				t.Errorf("order = %v, want %v", order, want)
+			}
+		})
+	}
+}
+
+// Lock values written before holds carried a start time and extension
+// count are still honoured.
+func TestRedisSeatLockerLegacyValues(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+	expiry := time.Now().Add(time.Minute)
+	for _, seat := range []string{"A1", "A2"} {
+		client.Set(ctx, seatLockKey(1, seat), "5:old", time.Minute)
+		client.ZAdd(ctx, seatHoldRegistryKey(1), redis.Z{Score: float64(expiry.UnixMilli()), Member: seat})
+	}
+	l := &redisSeatLocker{redis: client}
+
+	seats, err := l.List(ctx, 1)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	for _, s := range seats {
+		if s.Owner != (biz.SeatHold{UserID: 5, Token: "old"}) {
+			t.Errorf("seat %s owner = %+v, want user 5 with token old", s.SeatID, s.Owner)
+		}
+	}
+	res, err := l.Hold(ctx, 1, []string{"A1"}, biz.SeatHold{UserID: 5}, "new", testHoldPolicy)
+	if err != nil || !reflect.DeepEqual(res.Conflicts, []string{"A1"}) {
+		t.Fatalf("Hold without the token = %+v, %v; want a conflict on A1", res, err)
+	}
+	res, err = l.Hold(ctx, 1, []string{"A1"}, biz.SeatHold{UserID: 5, Token: "old"}, "new", testHoldPolicy)
+	if err != nil || len(res.Conflicts) > 0 {
+		t.Fatalf("Hold with the token = %+v, %v; want it taken over", res, err)
+	}
+	conflicts, err := l.Release(ctx, 1, []string{"A2"}, biz.SeatHold{UserID: 5, Token: "old"})
+	if err != nil || len(conflicts) > 0 {
+		t.Fatalf("Release = %v, %v; want it released", conflicts, err)
+	}
+	seats, _ = l.List(ctx, 1)
+	var left []string
+	for _, s := range seats {
+		left = append(left, s.SeatID+":"+s.Owner.Token)
+	}
+	sort.Strings(left)
+	if want := []string{"A1:new"}; !reflect.DeepEqual(left, want) {
+		t.Errorf("left = %v, want %v", left, want)
+	}
+}
@@ -0,0 +1,155 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// Ticket DB model. A seat can have only one VALID ticket per booking.
+type Ticket struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	BookingID uint64 `gorm:"not null;index;uniqueIndex:idx_tickets_valid,where:status = 'TICKET_VALID'"`
+	EventID   uint64 `gorm:"not null;index"`
+	SeatID    string `gorm:"size:64;not null;uniqueIndex:idx_tickets_valid"`
+	Code      string `gorm:"size:32;not null;uniqueIndex"`
+	Status    string `gorm:"size:20;not null"`
+	IssuedAt  time.Time
+	VoidedAt  *time.Time
+	// CheckedInAt is set once, by the scan that admits the ticket.
+	CheckedInAt   *time.Time `gorm:"index"`
+	CheckedInGate string     `gorm:"size:64"`
+}
+
+type ticketRepo struct {
+	db *gorm.DB
+}
+
+func NewTicketRepo(db *gorm.DB) biz.TicketRepo {
+	db.AutoMigrate(&Ticket{})
+	return &ticketRepo{db: db}
+}
+
+func toTicket(m *Ticket) *biz.Ticket {
+	t := &biz.Ticket{
+		ID:        m.ID,
+		BookingID: m.BookingID,
+		EventID:   m.EventID,
+		SeatID:    m.SeatID,
+		Code:      m.Code,
+		Status:    v1.TicketStatus(v1.TicketStatus_value[m.Status]),
+		IssuedAt:  m.IssuedAt,
+	}
+	if m.VoidedAt != nil {
+		t.VoidedAt = *m.VoidedAt
+	}
+	if m.CheckedInAt != nil {
+		t.CheckedInAt = *m.CheckedInAt
+		t.CheckedInGate = m.CheckedInGate
+	}
+	return t
+}
+
+func (r *ticketRepo) Create(ctx context.Context, tickets []*biz.Ticket) error {
+	now := time.Now()
+	models := make([]Ticket, 0, len(tickets))
+	for _, t := range tickets {
+		models = append(models, Ticket{
+			BookingID: t.BookingID,
+			EventID:   t.EventID,
+			SeatID:    t.SeatID,
+			Code:      t.Code,
+			Status:    t.Status.String(),
+			IssuedAt:  now,
+		})
+	}
+	if err := dbFrom(ctx, r.db).Create(&models).Error; err != nil {
+		return err
+	}
+	for i, t := range tickets {
+		t.ID = models[i].ID
+		t.IssuedAt = now
+	}
+	return nil
+}
+
+func (r *ticketRepo) ListByBooking(ctx context.Context, bookingID uint64, includeVoid bool) ([]*biz.Ticket, error) {
+	q := dbFrom(ctx, r.db).Where("booking_id = ?", bookingID)
+	if !includeVoid {
+		q = q.Where("status = ?", v1.TicketStatus_TICKET_VALID.String())
+	}
+	var models []Ticket
+	if err := q.Order("id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.Ticket, 0, len(models))
+	for i := range models {
+		res = append(res, toTicket(&models[i]))
+	}
+	return res, nil
+}
+
+func (r *ticketRepo) Void(ctx context.Context, bookingID uint64, seatIDs []string) error {
+	q := dbFrom(ctx, r.db).Model(&Ticket{}).
+		Where("booking_id = ? AND status = ?", bookingID, v1.TicketStatus_TICKET_VALID.String())
+	if seatIDs != nil {
+		q = q.Where("seat_id IN ?", seatIDs)
+	}
+	return q.Updates(map[string]interface{}{
+		"status":    v1.TicketStatus_TICKET_VOID.String(),
+		"voided_at": time.Now(),
+	}).Error
+}
+
+func (r *ticketRepo) GetByCode(ctx context.Context, code string) (*biz.Ticket, error) {
+	var m Ticket
+	if err := dbFrom(ctx, r.db).Where("code = ?", code).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorTicketNotFound("no ticket has code %s", code)
+		}
+		return nil, err
+	}
+	return toTicket(&m), nil
+}
+
+func (r *ticketRepo) CheckIn(ctx context.Context, id uint64, gate string, at time.Time) (bool, error) {
+	res := dbFrom(ctx, r.db).Model(&Ticket{}).
+		Where("id = ? AND status = ? AND checked_in_at IS NULL", id, v1.TicketStatus_TICKET_VALID.String()).
+		Updates(map[string]interface{}{"checked_in_at": at, "checked_in_gate": gate})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *ticketRepo) Attendance(ctx context.Context, eventID uint64) (*biz.Attendance, error) {
+	a := &biz.Attendance{EventID: eventID, ByGate: make(map[string]int64)}
+	valid := dbFrom(ctx, r.db).Model(&Ticket{}).
+		Where("event_id = ? AND status = ?", eventID, v1.TicketStatus_TICKET_VALID.String())
+	if err := valid.Count(&a.Tickets).Error; err != nil {
+		return nil, err
+	}
+
+	// Admissions of tickets voided after the scan still count as attendance
+	var gates []struct {
+		Gate  string
+		Count int64
+	}
+	if err := dbFrom(ctx, r.db).Model(&Ticket{}).
+		Select("checked_in_gate AS gate, COUNT(*) AS count").
+		Where("event_id = ? AND checked_in_at IS NOT NULL", eventID).
+		Group("checked_in_gate").
+		Scan(&gates).Error; err != nil {
+		return nil, err
+	}
+	for _, g := range gates {
+		a.ByGate[g.Gate] = g.Count
+		a.CheckedIn += g.Count
+	}
+	return a, nil
+}
@@ -0,0 +1,71 @@
+package data
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+type contextTxKey struct{}
+
+type contextCommitHooksKey struct{}
+
+// commitHooks collects the functions to run once the outermost transaction
+// commits.
+type commitHooks struct {
+	fns []func()
+}
+
+type transaction struct {
+	db *gorm.DB
+}
+
+// NewTransaction returns a biz.Transaction backed by GORM transactions.
+func NewTransaction(db *gorm.DB) biz.Transaction {
+	return &transaction{db: db}
+}
+
+// InTx runs fn in a transaction, or in a savepoint of the transaction ctx
+// already carries. Hooks registered with afterCommit run once the outermost
+// transaction commits; those of a savepoint that rolls back are dropped.
+func (t *transaction) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	hooks, nested := ctx.Value(contextCommitHooksKey{}).(*commitHooks)
+	if !nested {
+		hooks = &commitHooks{}
+		ctx = context.WithValue(ctx, contextCommitHooksKey{}, hooks)
+	}
+	registered := len(hooks.fns)
+	err := dbFrom(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextTxKey{}, tx))
+	})
+	if err != nil {
+		hooks.fns = hooks.fns[:registered]
+		return err
+	}
+	if !nested {
+		for _, fn := range hooks.fns {
+			fn()
+		}
+	}
+	return nil
+}
+
+// dbFrom returns the transaction carried by ctx, if any, or db bound to ctx.
+func dbFrom(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(contextTxKey{}).(*gorm.DB); ok {
+		return tx
+	}
+	return db.WithContext(ctx)
+}
+
+// afterCommit runs fn once the transaction ctx carries commits, or right
+// away outside a transaction.
+func afterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(contextCommitHooksKey{}).(*commitHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+	fn()
+}
@@ -0,0 +1,109 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+)
+
+// Transfer DB model. Rows are kept after they are resolved, as the
+// booking's ownership history; only one transfer per booking may be PENDING.
+type Transfer struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement"`
+	BookingID      uint64 `gorm:"not null;index;uniqueIndex:idx_transfers_pending,where:state = 'TRANSFER_PENDING'"`
+	FromUserID     uint64 `gorm:"not null;index"`
+	ToUserID       uint64 `gorm:"not null;index"`
+	RecipientEmail string `gorm:"size:255;not null"`
+	State          string `gorm:"size:32;not null"`
+	ExpiresAt      time.Time
+	ResolvedAt     *time.Time
+	ResolvedBy     uint64
+	CreatedAt      time.Time
+}
+
+type transferRepo struct {
+	db *gorm.DB
+}
+
+func NewTransferRepo(db *gorm.DB) biz.TransferRepo {
+	db.AutoMigrate(&Transfer{})
+	return &transferRepo{db: db}
+}
+
+func toTransfer(m *Transfer) *biz.Transfer {
+	t := &biz.Transfer{
+		ID:             m.ID,
+		BookingID:      m.BookingID,
+		FromUserID:     m.FromUserID,
+		ToUserID:       m.ToUserID,
+		RecipientEmail: m.RecipientEmail,
+		State:          v1.TransferState(v1.TransferState_value[m.State]),
+		CreatedAt:      m.CreatedAt,
+		ExpiresAt:      m.ExpiresAt,
+		ResolvedBy:     m.ResolvedBy,
+	}
+	if m.ResolvedAt != nil {
+		t.ResolvedAt = *m.ResolvedAt
+	}
+	return t
+}
+
+func (r *transferRepo) Create(ctx context.Context, t *biz.Transfer) (*biz.Transfer, error) {
+	m := &Transfer{
+		BookingID:      t.BookingID,
+		FromUserID:     t.FromUserID,
+		ToUserID:       t.ToUserID,
+		RecipientEmail: t.RecipientEmail,
+		State:          t.State.String(),
+		ExpiresAt:      t.ExpiresAt,
+		CreatedAt:      t.CreatedAt,
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorTransferAlreadyPending("booking %d already has a pending transfer", t.BookingID)
+		}
+		return nil, err
+	}
+	return toTransfer(m), nil
+}
+
+func (r *transferRepo) Get(ctx context.Context, id uint64) (*biz.Transfer, error) {
+	var m Transfer
+	if err := dbFrom(ctx, r.db).First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	return toTransfer(&m), nil
+}
+
+func (r *transferRepo) Resolve(ctx context.Context, id uint64, to v1.TransferState, by uint64) (bool, error) {
+	res := dbFrom(ctx, r.db).Model(&Transfer{}).
+		Where("id = ? AND state = ?", id, v1.TransferState_TRANSFER_PENDING.String()).
+		Updates(map[string]interface{}{
+			"state":       to.String(),
+			"resolved_at": time.Now(),
+			"resolved_by": by,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *transferRepo) ListByBooking(ctx context.Context, bookingID uint64) ([]*biz.Transfer, error) {
+	var models []Transfer
+	if err := dbFrom(ctx, r.db).
+		Where("booking_id = ?", bookingID).
+		Order("id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.Transfer, 0, len(models))
+	for i := range models {
+		res = append(res, toTransfer(&models[i]))
+	}
+	return res, nil
+}
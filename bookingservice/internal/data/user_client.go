@@ -0,0 +1,26 @@
+package data
+
+import (
+	"context"
+	userv1 "userservice/api/userservice/v1"
+
+	"github.com/go-kratos/kratos/v2/transport/grpc"
+)
+
+// ProvideUserClient creates a gRPC client to UserService
+func ProvideUserClient() (userv1.UserServiceClient, func(), error) {
+	conn, err := grpc.DialInsecure(
+		context.Background(),
+		grpc.WithEndpoint("127.0.0.1:9000"), // UserService gRPC port
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cleanup := func() {
+		_ = conn.Close()
+	}
+
+	client := userv1.NewUserServiceClient(conn)
+	return client, cleanup, nil
+}
@@ -0,0 +1,263 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"bookingservice/internal/biz"
+	"bookingservice/internal/conf"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// queueTTL is how long a queue nobody joins is kept.
+const queueTTL = 24 * time.Hour
+
+// queuesKey is the set of events with a queue.
+const queuesKey = "booking:queues"
+
+// Each queue is a counter handing out numbers, a hash of user to number, a
+// counter of the last number let in and a hash of user to when their
+// admission lapses, in unix milliseconds.
+func queueKeys(eventID uint64) []string {
+	prefix := fmt.Sprintf("booking:queue:%d:", eventID)
+	return []string{prefix + "seq", prefix + "users", prefix + "serving", prefix + "admitted", queuesKey}
+}
+
+// joinQueueScript keeps the number of a user who is waiting or still
+// admitted, and otherwise gives them the next one. ARGV is the user, now in
+// unix milliseconds, the queue TTL in seconds and the event. It returns the
+// number, the last number let in and the admission expiry, 0 if none.
+var joinQueueScript = redis.NewScript(`
+local serving = tonumber(redis.call('GET', KEYS[3]) or '0')
+local n = redis.call('HGET', KEYS[2], ARGV[1])
+if n then
+  local expiry = tonumber(redis.call('HGET', KEYS[4], ARGV[1]) or '0')
+  if expiry == 0 or expiry > tonumber(ARGV[2]) then
+    return {tonumber(n), serving, expiry}
+  end
+  redis.call('HDEL', KEYS[4], ARGV[1])
+end
+n = redis.call('INCR', KEYS[1])
+redis.call('HSET', KEYS[2], ARGV[1], n)
+for i = 1, 4 do
+  redis.call('EXPIRE', KEYS[i], ARGV[3])
+end
+redis.call('SADD', KEYS[5], ARGV[4])
+return {n, serving, 0}
+`)
+
+// advanceQueueScript moves the last number let in forward by up to
+// ARGV[1], never past the last number handed out, and returns how far it
+// moved. A queue that has expired is dropped from the set of queues.
+var advanceQueueScript = redis.NewScript(`
+local seq = tonumber(redis.call('GET', KEYS[1]) or '0')
+if seq == 0 then
+  redis.call('SREM', KEYS[5], ARGV[3])
+  return 0
+end
+local serving = tonumber(redis.call('GET', KEYS[3]) or '0')
+local upto = math.min(serving + tonumber(ARGV[1]), seq)
+if upto > serving then
+  redis.call('SET', KEYS[3], upto, 'EX', ARGV[2])
+end
+return upto - serving
+`)
+
+type waitingRoomRepo struct {
+	redis *redis.Client
+}
+
+// NewWaitingRoomRepo returns a biz.WaitingRoomRepo backed by Redis, or kept
+// in process when running without Redis.
+func NewWaitingRoomRepo(c *conf.Data, redis *redis.Client) biz.WaitingRoomRepo {
+	if inProcess(c) {
+		return &memoryWaitingRoomRepo{queues: make(map[uint64]*memoryQueue)}
+	}
+	return &waitingRoomRepo{redis: redis}
+}
+
+func (r *waitingRoomRepo) Join(ctx context.Context, eventID, userID uint64, now time.Time) (*biz.QueueEntry, error) {
+	reply, err := joinQueueScript.Run(ctx, r.redis, queueKeys(eventID),
+		userID, now.UnixMilli(), int64(queueTTL.Seconds()), eventID).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) != 3 {
+		return nil, fmt.Errorf("unexpected join queue reply %v", reply)
+	}
+	return queueEntry(reply[0], reply[1], reply[2]), nil
+}
+
+func (r *waitingRoomRepo) Get(ctx context.Context, eventID, userID uint64) (*biz.QueueEntry, error) {
+	keys := queueKeys(eventID)
+	user := strconv.FormatUint(userID, 10)
+	pipe := r.redis.Pipeline()
+	number := pipe.HGet(ctx, keys[1], user)
+	serving := pipe.Get(ctx, keys[2])
+	until := pipe.HGet(ctx, keys[3], user)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+	n, err := number.Int64()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s, err := serving.Int64()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	u, err := until.Int64()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	return queueEntry(n, s, u), nil
+}
+
+func (r *waitingRoomRepo) Admit(ctx context.Context, eventID, userID uint64, until time.Time) (time.Time, error) {
+	key := queueKeys(eventID)[3]
+	user := strconv.FormatUint(userID, 10)
+	var recorded *redis.StringCmd
+	_, err := r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, key, user, until.UnixMilli())
+		pipe.Expire(ctx, key, queueTTL)
+		recorded = pipe.HGet(ctx, key, user)
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	ms, err := recorded.Int64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMilli(ms), nil
+}
+
+func (r *waitingRoomRepo) Advance(ctx context.Context, eventID uint64, n int64) (int64, error) {
+	return advanceQueueScript.Run(ctx, r.redis, queueKeys(eventID), n, int64(queueTTL.Seconds()), eventID).Int64()
+}
+
+func (r *waitingRoomRepo) ListQueues(ctx context.Context) ([]uint64, error) {
+	members, err := r.redis.SMembers(ctx, queuesKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]uint64, 0, len(members))
+	for _, m := range members {
+		id, err := strconv.ParseUint(m, 10, 64)
+		if err != nil {
+			continue
+		}
+		events = append(events, id)
+	}
+	return events, nil
+}
+
+func queueEntry(number, serving, untilMillis int64) *biz.QueueEntry {
+	e := &biz.QueueEntry{Number: number, Serving: serving}
+	if untilMillis > 0 {
+		e.AdmittedUntil = time.UnixMilli(untilMillis)
+	}
+	return e
+}
+
+// memoryQueue is one event's queue in a memoryWaitingRoomRepo, shaped like
+// the Redis keys.
+type memoryQueue struct {
+	seq      int64
+	serving  int64
+	users    map[uint64]int64
+	admitted map[uint64]time.Time
+	joinedAt time.Time
+}
+
+type memoryWaitingRoomRepo struct {
+	mu     sync.Mutex
+	queues map[uint64]*memoryQueue
+}
+
+func (r *memoryWaitingRoomRepo) Join(ctx context.Context, eventID, userID uint64, now time.Time) (*biz.QueueEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queues[eventID]
+	if !ok {
+		q = &memoryQueue{users: make(map[uint64]int64), admitted: make(map[uint64]time.Time)}
+		r.queues[eventID] = q
+	}
+	if n, ok := q.users[userID]; ok {
+		until := q.admitted[userID]
+		if until.IsZero() || until.After(now) {
+			return &biz.QueueEntry{Number: n, Serving: q.serving, AdmittedUntil: until}, nil
+		}
+		delete(q.admitted, userID)
+	}
+	q.seq++
+	q.users[userID] = q.seq
+	q.joinedAt = now
+	return &biz.QueueEntry{Number: q.seq, Serving: q.serving}, nil
+}
+
+func (r *memoryWaitingRoomRepo) Get(ctx context.Context, eventID, userID uint64) (*biz.QueueEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queues[eventID]
+	if !ok {
+		return nil, nil
+	}
+	n, ok := q.users[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &biz.QueueEntry{Number: n, Serving: q.serving, AdmittedUntil: q.admitted[userID]}, nil
+}
+
+func (r *memoryWaitingRoomRepo) Admit(ctx context.Context, eventID, userID uint64, until time.Time) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queues[eventID]
+	if !ok {
+		return time.Time{}, fmt.Errorf("event %d has no queue", eventID)
+	}
+	if recorded, ok := q.admitted[userID]; ok {
+		return recorded, nil
+	}
+	q.admitted[userID] = until
+	return until, nil
+}
+
+func (r *memoryWaitingRoomRepo) Advance(ctx context.Context, eventID uint64, n int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	q, ok := r.queues[eventID]
+	if !ok {
+		return 0, nil
+	}
+	if time.Since(q.joinedAt) > queueTTL {
+		delete(r.queues, eventID)
+		return 0, nil
+	}
+	upto := q.serving + n
+	if upto > q.seq {
+		upto = q.seq
+	}
+	moved := upto - q.serving
+	q.serving = upto
+	return moved, nil
+}
+
+func (r *memoryWaitingRoomRepo) ListQueues(ctx context.Context) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := make([]uint64, 0, len(r.queues))
+	for eventID := range r.queues {
+		events = append(events, eventID)
+	}
+	return events, nil
+}
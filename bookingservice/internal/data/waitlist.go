@@ -0,0 +1,173 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// WaitlistEntry DB model. A user can have only one open (WAITING or
+// OFFERED) entry per event.
+type WaitlistEntry struct {
+	ID             uint64 `gorm:"primaryKey;autoIncrement"`
+	EventID        uint64 `gorm:"not null;index:idx_waitlist_queue,priority:1;uniqueIndex:idx_waitlist_open,where:state IN ('WAITLIST_WAITING','WAITLIST_OFFERED')"`
+	UserID         uint64 `gorm:"not null;index;uniqueIndex:idx_waitlist_open"`
+	SeatCount      int32  `gorm:"not null"`
+	State          string `gorm:"size:32;not null;index:idx_waitlist_queue,priority:2"`
+	OfferExpiresAt *time.Time
+	BookingID      uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type waitlistRepo struct {
+	db *gorm.DB
+}
+
+func NewWaitlistRepo(db *gorm.DB) biz.WaitlistRepo {
+	db.AutoMigrate(&WaitlistEntry{})
+	return &waitlistRepo{db: db}
+}
+
+func toWaitlistEntry(m *WaitlistEntry) *biz.WaitlistEntry {
+	e := &biz.WaitlistEntry{
+		ID:        m.ID,
+		EventID:   m.EventID,
+		UserID:    m.UserID,
+		SeatCount: m.SeatCount,
+		State:     v1.WaitlistState(v1.WaitlistState_value[m.State]),
+		BookingID: m.BookingID,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.OfferExpiresAt != nil {
+		e.OfferExpiresAt = *m.OfferExpiresAt
+	}
+	return e
+}
+
+func (r *waitlistRepo) Join(ctx context.Context, entry *biz.WaitlistEntry) (*biz.WaitlistEntry, error) {
+	m := &WaitlistEntry{
+		EventID:   entry.EventID,
+		UserID:    entry.UserID,
+		SeatCount: entry.SeatCount,
+		State:     entry.State.String(),
+	}
+	if err := dbFrom(ctx, r.db).Create(m).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, v1.ErrorAlreadyOnWaitlist("user %d is already on the waitlist of event %d", entry.UserID, entry.EventID)
+		}
+		return nil, err
+	}
+	return toWaitlistEntry(m), nil
+}
+
+func (r *waitlistRepo) Get(ctx context.Context, id uint64) (*biz.WaitlistEntry, error) {
+	var m WaitlistEntry
+	if err := dbFrom(ctx, r.db).First(&m, id).Error; err != nil {
+		return nil, err
+	}
+	return toWaitlistEntry(&m), nil
+}
+
+func (r *waitlistRepo) Position(ctx context.Context, entry *biz.WaitlistEntry) (int32, error) {
+	var ahead int64
+	if err := dbFrom(ctx, r.db).Model(&WaitlistEntry{}).
+		Where("event_id = ? AND state = ? AND id < ?", entry.EventID, v1.WaitlistState_WAITLIST_WAITING.String(), entry.ID).
+		Count(&ahead).Error; err != nil {
+		return 0, err
+	}
+	return int32(ahead) + 1, nil
+}
+
+func (r *waitlistRepo) LockQueue(ctx context.Context, eventID uint64) ([]*biz.WaitlistEntry, error) {
+	var models []WaitlistEntry
+	if err := dbFrom(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("event_id = ? AND state IN ?", eventID, []string{
+			v1.WaitlistState_WAITLIST_WAITING.String(),
+			v1.WaitlistState_WAITLIST_OFFERED.String(),
+		}).
+		Order("id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.WaitlistEntry, 0, len(models))
+	for i := range models {
+		res = append(res, toWaitlistEntry(&models[i]))
+	}
+	return res, nil
+}
+
+func (r *waitlistRepo) Offer(ctx context.Context, id uint64, expiresAt time.Time) (bool, error) {
+	res := dbFrom(ctx, r.db).Model(&WaitlistEntry{}).
+		Where("id = ? AND state = ?", id, v1.WaitlistState_WAITLIST_WAITING.String()).
+		Updates(map[string]interface{}{
+			"state":            v1.WaitlistState_WAITLIST_OFFERED.String(),
+			"offer_expires_at": expiresAt,
+			"updated_at":       time.Now(),
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *waitlistRepo) UpdateState(ctx context.Context, id uint64, from, to v1.WaitlistState, bookingID uint64) (bool, error) {
+	updates := map[string]interface{}{"state": to.String(), "updated_at": time.Now()}
+	if bookingID != 0 {
+		updates["booking_id"] = bookingID
+	}
+	res := dbFrom(ctx, r.db).Model(&WaitlistEntry{}).
+		Where("id = ? AND state = ?", id, from.String()).
+		Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *waitlistRepo) ReservedSeats(ctx context.Context, eventID, exceptUserID uint64) (int32, error) {
+	var reserved int64
+	if err := dbFrom(ctx, r.db).Model(&WaitlistEntry{}).
+		Select("COALESCE(SUM(seat_count), 0)").
+		Where("event_id = ? AND state = ? AND offer_expires_at > ? AND user_id <> ?",
+			eventID, v1.WaitlistState_WAITLIST_OFFERED.String(), time.Now(), exceptUserID).
+		Scan(&reserved).Error; err != nil {
+		return 0, err
+	}
+	return int32(reserved), nil
+}
+
+func (r *waitlistRepo) ListLapsedOffers(ctx context.Context, before time.Time, limit int) ([]*biz.WaitlistEntry, error) {
+	var models []WaitlistEntry
+	if err := dbFrom(ctx, r.db).
+		Where("state = ? AND offer_expires_at <= ?", v1.WaitlistState_WAITLIST_OFFERED.String(), before).
+		Order("offer_expires_at").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	res := make([]*biz.WaitlistEntry, 0, len(models))
+	for i := range models {
+		res = append(res, toWaitlistEntry(&models[i]))
+	}
+	return res, nil
+}
+
+func (r *waitlistRepo) ListWaitingEvents(ctx context.Context, limit int) ([]uint64, error) {
+	var ids []uint64
+	if err := dbFrom(ctx, r.db).Model(&WaitlistEntry{}).
+		Distinct("event_id").
+		Where("state = ?", v1.WaitlistState_WAITLIST_WAITING.String()).
+		Order("event_id").
+		Limit(limit).
+		Pluck("event_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// serviceRole is the role claim of the tokens other services sign their
+// calls with.
+const serviceRole = "service"
+
+// authenticatedOperations need a bearer token from userservice, or for the
+// services-only ones a service token. The other operations stay open.
+var authenticatedOperations = map[string]bool{
+	v1.OperationBookingServiceGetBookingHistory:    true,
+	v1.OperationBookingServiceListMyBookings:       true,
+	v1.OperationBookingServiceCancelBooking:        true,
+	v1.OperationBookingServiceCancelSeats:          true,
+	v1.OperationBookingServiceChangeSeats:          true,
+	v1.OperationBookingServiceConfirmBooking:       true,
+	v1.OperationBookingServiceTransferBooking:      true,
+	v1.OperationBookingServiceAcceptTransfer:       true,
+	v1.OperationBookingServiceCancelTransfer:       true,
+	v1.OperationBookingServiceGetTransfer:          true,
+	v1.OperationBookingServiceListBookingTransfers: true,
+	v1.OperationBookingServiceListTickets:          true,
+	v1.OperationBookingServiceJoinWaitlist:         true,
+	v1.OperationBookingServiceLeaveWaitlist:        true,
+	v1.OperationBookingServiceAcceptWaitlistOffer:  true,
+
+	// staff and admin only; the service checks the token's role
+	v1.OperationCheckInServiceCheckIn:              true,
+	v1.OperationCheckInServiceGetAttendance:        true,
+	v1.OperationInventoryServiceReconcileInventory: true,
+	v1.OperationPromoServiceCreatePromoCode:        true,
+
+	// services only; the service checks the token's role and subject
+	v1.BookingService_UpdateBooking_FullMethodName: true,
+}
+
+// optionallyAuthenticatedOperations check the bearer token only when the
+// request carries one.
+var optionallyAuthenticatedOperations = map[string]bool{
+	v1.OperationBookingServiceCreateBooking: true,
+	v1.OperationBookingServiceGetBooking:    true,
+}
+
+// authMiddleware checks the HS256 bearer token on authenticatedOperations,
+// and on optionallyAuthenticatedOperations that carry one.
+func authMiddleware(c *conf.Server) middleware.Middleware {
+	userKey := []byte(c.GetAuth().GetJwtKey())
+	serviceKey := []byte(c.GetAuth().GetServiceKey())
+	return selector.Server(
+		jwt.Server(
+			func(token *jwtv5.Token) (interface{}, error) {
+				// Only the services hold the key that service tokens are
+				// signed with, so a user token cannot claim the role
+				if claims, ok := token.Claims.(*jwtv5.MapClaims); ok && (*claims)["role"] == serviceRole {
+					if len(serviceKey) == 0 {
+						return nil, errors.New("no service key configured")
+					}
+					return serviceKey, nil
+				}
+				return userKey, nil
+			},
+			jwt.WithSigningMethod(jwtv5.SigningMethodHS256),
+			jwt.WithClaims(func() jwtv5.Claims { return &jwtv5.MapClaims{} }),
+		),
+	).Match(func(ctx context.Context, operation string) bool {
+		return authenticatedOperations[operation] ||
+			optionallyAuthenticatedOperations[operation] && hasBearerToken(ctx)
+	}).Build()
+}
+
+func hasBearerToken(ctx context.Context) bool {
+	tr, ok := transport.FromServerContext(ctx)
+	return ok && tr.RequestHeader().Get("Authorization") != ""
+}
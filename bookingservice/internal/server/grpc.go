@@ -11,10 +11,11 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, greeter *service.BookingService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, greeter *service.BookingService, checkIn *service.CheckInService, promo *service.PromoService, waitingRoom *service.WaitingRoomService, inventory *service.InventoryService, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			authMiddleware(c),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -28,5 +29,9 @@ func NewGRPCServer(c *conf.Server, greeter *service.BookingService, logger log.L
 	}
 	srv := grpc.NewServer(opts...)
 	v1.RegisterBookingServiceServer(srv, greeter)
+	v1.RegisterCheckInServiceServer(srv, checkIn)
+	v1.RegisterPromoServiceServer(srv, promo)
+	v1.RegisterWaitingRoomServiceServer(srv, waitingRoom)
+	v1.RegisterInventoryServiceServer(srv, inventory)
 	return srv
 }
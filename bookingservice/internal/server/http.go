@@ -12,21 +12,22 @@ import (
 )
 
 // NewHTTPServer creates a new HTTP server with CORS support
-func NewHTTPServer(c *conf.Server, bookingService *service.BookingService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, bookingService *service.BookingService, checkInService *service.CheckInService, promoService *service.PromoService, waitingRoomService *service.WaitingRoomService, inventoryService *service.InventoryService, logger log.Logger) *http.Server {
 	// ✅ Setup CORS middleware (works for React frontend, Postman, and other origins)
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:5173"}, // your React dev server
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "Idempotency-Key"},
 		AllowCredentials: true,
 	})
 
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			authMiddleware(c),
 		),
 		// 👇 Add CORS as a transport filter (applied to all routes)
-		http.Filter(corsMiddleware.Handler),
+		http.Filter(corsMiddleware.Handler, seatStreamFilter(bookingService)),
 	}
 
 	if c.Http.Network != "" {
@@ -44,6 +45,10 @@ func NewHTTPServer(c *conf.Server, bookingService *service.BookingService, logge
 
 	// ✅ Register your BookingService routes
 	v1.RegisterBookingServiceHTTPServer(srv, bookingService)
+	v1.RegisterCheckInServiceHTTPServer(srv, checkInService)
+	v1.RegisterPromoServiceHTTPServer(srv, promoService)
+	v1.RegisterWaitingRoomServiceHTTPServer(srv, waitingRoomService)
+	v1.RegisterInventoryServiceHTTPServer(srv, inventoryService)
 
 	return srv
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// InventoryReconciler checks the events' seat counts against the bookings
+// on a timer. It implements transport.Server so it starts and stops with
+// the kratos app.
+type InventoryReconciler struct {
+	uc   *biz.InventoryUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewInventoryReconciler creates the background inventory check.
+func NewInventoryReconciler(uc *biz.InventoryUsecase, logger log.Logger) *InventoryReconciler {
+	return &InventoryReconciler{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, checking the inventory every interval, until ctx is done
+// or Stop is called.
+func (w *InventoryReconciler) Start(ctx context.Context) error {
+	w.log.Infof("[inventory] reconciling seat counts every %s", w.uc.Interval())
+	runEvery(ctx, w.stop, w.uc.Interval(), func(ctx context.Context) {
+		if _, err := w.uc.Process(ctx); err != nil {
+			w.log.Errorf("[inventory] pass failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Stop ends the checking loop.
+func (w *InventoryReconciler) Stop(ctx context.Context) error {
+	close(w.stop)
+	return nil
+}
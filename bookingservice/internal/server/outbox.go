@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OutboxRelay delivers outbox messages on a timer. It implements
+// transport.Server so it starts and stops with the kratos app.
+type OutboxRelay struct {
+	uc   *biz.OutboxUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewOutboxRelay creates the background relay for booking outbox messages.
+func NewOutboxRelay(uc *biz.OutboxUsecase, logger log.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, relaying every interval, until ctx is done or Stop is called.
+func (s *OutboxRelay) Start(ctx context.Context) error {
+	s.log.Infof("[outbox] relaying booking events every %s", s.uc.Interval())
+	runEvery(ctx, s.stop, s.uc.Interval(), func(ctx context.Context) {
+		if _, err := s.uc.Relay(ctx); err != nil {
+			s.log.Errorf("[outbox] relay failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Stop ends the relay loop.
+func (s *OutboxRelay) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	sagaRecoveryInterval = time.Minute
+	sagaRecoveryBatch    = 100
+)
+
+// SagaRecovery finishes or compensates confirm sagas that were cut off by a
+// crash or restart. It runs once when the app starts and then every minute.
+// Sagas only move by compare-and-set on their state, so several replicas
+// can run it at once.
+type SagaRecovery struct {
+	uc   *biz.BookingUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewSagaRecovery creates the background recovery pass for confirm sagas.
+func NewSagaRecovery(uc *biz.BookingUsecase, logger log.Logger) *SagaRecovery {
+	return &SagaRecovery{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *SagaRecovery) recover(ctx context.Context) {
+	n, err := s.uc.RecoverSagas(ctx, sagaRecoveryBatch)
+	if err != nil {
+		s.log.Errorf("[saga] recovery failed: %v", err)
+		return
+	}
+	if n > 0 {
+		s.log.Infof("[saga] recovered %d confirm sagas", n)
+	}
+}
+
+// Start runs a recovery pass, then repeats it every interval until ctx is
+// done or Stop is called.
+func (s *SagaRecovery) Start(ctx context.Context) error {
+	s.recover(ctx)
+	runEvery(ctx, s.stop, sagaRecoveryInterval, s.recover)
+	return nil
+}
+
+// Stop ends the recovery loop.
+func (s *SagaRecovery) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
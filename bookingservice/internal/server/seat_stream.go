@@ -0,0 +1,38 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"bookingservice/internal/service"
+)
+
+// seatStreamFilter serves GET /v1/events/{event_id}/seats/stream ahead of
+// the kratos router, whose per-request timeout would end the stream.
+func seatStreamFilter(bookingService *service.BookingService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			eventID, ok := seatStreamEventID(r.URL.Path)
+			if !ok || r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			bookingService.StreamSeats(w, r, eventID)
+		})
+	}
+}
+
+// seatStreamEventID matches /v1/events/{event_id}/seats/stream.
+func seatStreamEventID(path string) (uint64, bool) {
+	rest, ok := strings.CutPrefix(path, "/v1/events/")
+	if !ok {
+		return 0, false
+	}
+	id, ok := strings.CutSuffix(rest, "/seats/stream")
+	if !ok {
+		return 0, false
+	}
+	eventID, err := strconv.ParseUint(id, 10, 64)
+	return eventID, err == nil
+}
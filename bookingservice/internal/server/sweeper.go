@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// BookingSweeper runs the PENDING booking expiry on a timer. It implements
+// transport.Server so it starts and stops with the kratos app.
+type BookingSweeper struct {
+	uc   *biz.ExpiryUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewBookingSweeper creates the background sweeper for abandoned bookings.
+func NewBookingSweeper(uc *biz.ExpiryUsecase, logger log.Logger) *BookingSweeper {
+	return &BookingSweeper{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, sweeping every interval, until ctx is done or Stop is called.
+func (s *BookingSweeper) Start(ctx context.Context) error {
+	s.log.Infof("[sweeper] expiring abandoned bookings every %s", s.uc.Interval())
+	runEvery(ctx, s.stop, s.uc.Interval(), func(ctx context.Context) {
+		if _, err := s.uc.Sweep(ctx); err != nil {
+			s.log.Errorf("[sweeper] sweep failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Stop ends the sweep loop.
+func (s *BookingSweeper) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WaitingRoomWorker lets the next visitors of every waiting room in on a
+// timer. It implements transport.Server so it starts and stops with the
+// kratos app.
+type WaitingRoomWorker struct {
+	uc   *biz.WaitingRoomUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewWaitingRoomWorker creates the background worker for waiting rooms.
+func NewWaitingRoomWorker(uc *biz.WaitingRoomUsecase, logger log.Logger) *WaitingRoomWorker {
+	return &WaitingRoomWorker{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, advancing the queues every interval, until ctx is done or
+// Stop is called.
+func (w *WaitingRoomWorker) Start(ctx context.Context) error {
+	w.log.Infof("[waiting-room] advancing queues every %s", w.uc.Interval())
+	runEvery(ctx, w.stop, w.uc.Interval(), func(ctx context.Context) {
+		if _, err := w.uc.Advance(ctx); err != nil {
+			w.log.Errorf("[waiting-room] pass failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Stop ends the advancing loop.
+func (w *WaitingRoomWorker) Stop(ctx context.Context) error {
+	close(w.stop)
+	return nil
+}
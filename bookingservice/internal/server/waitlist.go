@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+
+	"bookingservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// WaitlistWorker expires lapsed waitlist offers and offers free seats to
+// waiting users on a timer. It implements transport.Server so it starts and
+// stops with the kratos app.
+type WaitlistWorker struct {
+	uc   *biz.WaitlistUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewWaitlistWorker creates the background worker for event waitlists.
+func NewWaitlistWorker(uc *biz.WaitlistUsecase, logger log.Logger) *WaitlistWorker {
+	return &WaitlistWorker{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, processing the waitlists every interval, until ctx is done
+// or Stop is called.
+func (w *WaitlistWorker) Start(ctx context.Context) error {
+	w.log.Infof("[waitlist] processing waitlists every %s", w.uc.Interval())
+	runEvery(ctx, w.stop, w.uc.Interval(), func(ctx context.Context) {
+		if _, err := w.uc.Process(ctx); err != nil {
+			w.log.Errorf("[waitlist] pass failed: %v", err)
+		}
+	})
+	return nil
+}
+
+// Stop ends the processing loop.
+func (w *WaitlistWorker) Stop(ctx context.Context) error {
+	close(w.stop)
+	return nil
+}
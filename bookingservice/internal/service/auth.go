@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// Roles a userservice token can carry in its role claim. Customers' tokens
+// carry none; an admin may do anything staff may.
+const (
+	roleStaff = "staff"
+	roleAdmin = "admin"
+	// roleService is carried by the tokens other services sign their calls
+	// with, the subject naming the service.
+	roleService = "service"
+)
+
+// tokenClaims returns the claims of the request's bearer token.
+func tokenClaims(ctx context.Context) (jwtv5.MapClaims, error) {
+	claims, ok := jwt.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "missing bearer token")
+	}
+	mc, ok := claims.(*jwtv5.MapClaims)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "unexpected token claims")
+	}
+	return *mc, nil
+}
+
+// currentUserID returns the user_id claim of the request's bearer token,
+// as issued by userservice's Login.
+func currentUserID(ctx context.Context) (uint64, error) {
+	mc, err := tokenClaims(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// JSON numbers decode as float64
+	id, ok := mc["user_id"].(float64)
+	if !ok || id <= 0 {
+		return 0, errors.Unauthorized("UNAUTHORIZED", "token has no user_id")
+	}
+	return uint64(id), nil
+}
+
+// hasRole reports whether the request's bearer token carries role, or the
+// admin role.
+func hasRole(ctx context.Context, role string) bool {
+	mc, err := tokenClaims(ctx)
+	if err != nil {
+		return false
+	}
+	got, _ := mc["role"].(string)
+	return got != "" && (got == role || got == roleAdmin)
+}
+
+// requireRole fails unless the request's bearer token carries role, or the
+// admin role.
+func requireRole(ctx context.Context, role string) error {
+	if _, err := tokenClaims(ctx); err != nil {
+		return err
+	}
+	if !hasRole(ctx, role) {
+		return errors.Forbidden("FORBIDDEN", "needs the "+role+" role")
+	}
+	return nil
+}
+
+// requireService fails unless the request's bearer token is a service token
+// of the named service.
+func requireService(ctx context.Context, name string) error {
+	mc, err := tokenClaims(ctx)
+	if err != nil {
+		return err
+	}
+	role, _ := mc["role"].(string)
+	sub, _ := mc.GetSubject()
+	if role != roleService || sub != name {
+		return errors.Forbidden("FORBIDDEN", "only "+name+" may call this")
+	}
+	return nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	v1 "bookingservice/api/bookingservice/v1"
 	eventv1 "eventservice/api/eventservice/v1"
-	notifv1 "notificationservice/api/notificationservice/v1"
+	moneyv1 "eventservice/api/money/v1"
 	"bookingservice/internal/biz"
     "time"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 type BookingService struct {
@@ -15,19 +17,16 @@ type BookingService struct {
 	uc                  *biz.BookingUsecase
 	log                 *log.Helper
 	eventClient         eventv1.EventServiceClient
-	notificationClient  notifv1.NotificationServiceClient
 }
 
 func NewBookingService(
 	uc *biz.BookingUsecase,
-	notificationClient notifv1.NotificationServiceClient,
 	eventClient eventv1.EventServiceClient,
 	logger log.Logger,
 ) *BookingService {
 	return &BookingService{
 		uc:                 uc,
 		eventClient:        eventClient,
-		notificationClient: notificationClient,
 		log:                log.NewHelper(logger),
 	}
 }
@@ -37,29 +36,34 @@ func (s *BookingService) CreateBooking(ctx context.Context, req *v1.CreateBookin
     // 🔍 Log the incoming request to see what UserId is being sent
     s.log.Infof("Incoming CreateBookingRequest: UserId=%d, EventId=%d, SeatIds=%v", req.UserId, req.EventId, req.SeatIds)
 
-    // 1️⃣ Create booking
-    booking, err := s.uc.Create(ctx, req)
+    // A promo code's per-user limit counts the bearer token's user, so the
+    // booking must be that user's
+    if req.PromoCode != "" {
+        userID, err := currentUserID(ctx)
+        if err != nil {
+            return nil, err
+        }
+        if req.UserId != 0 && req.UserId != userID {
+            return nil, errors.Forbidden("FORBIDDEN", "user_id does not match the bearer token")
+        }
+        req.UserId = userID
+    }
+
+    // 1️⃣ Create booking (a replayed idempotency key returns the first booking)
+    if req.IdempotencyKey == "" {
+        req.IdempotencyKey = idempotencyKey(ctx)
+    }
+    booking, replayed, err := s.uc.CreateIdempotent(ctx, req)
     if err != nil {
         return nil, err
     }
+    if replayed {
+        return &v1.CreateBookingReply{Booking: booking}, nil
+    }
 
+    // The booking notification goes out through the outbox relay
     s.log.Infof("Booking created: Id=%d, UserId=%d, EventId=%d", booking.Id, booking.UserId, booking.EventId)
 
-    // 2️⃣ Send notification asynchronously
-    go func(bookingID uint64) {
-        ctxNotif, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-        defer cancel()
-
-        _, err := s.notificationClient.SendBookingNotification(ctxNotif, &notifv1.SendBookingNotificationRequest{
-            BookingId: bookingID,
-        })
-        if err != nil {
-            s.log.Errorf("Failed to send booking notification for booking %d: %v", bookingID, err)
-        } else {
-            s.log.Infof("Notification sent successfully for booking %d", bookingID)
-        }
-    }(booking.Id)
-
     return &v1.CreateBookingReply{Booking: booking}, nil
 }
 
@@ -69,86 +73,128 @@ func (s *BookingService) GetBooking(ctx context.Context, req *v1.GetBookingReque
 	if err != nil {
 		return nil, err
 	}
+	if req.IncludeHistory {
+		if err := checkHistoryAccess(ctx, booking); err != nil {
+			return nil, err
+		}
+		changes, err := s.uc.GetHistory(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		booking.History = statusChangesProto(changes)
+	}
 	return &v1.CreateBookingReply{Booking: booking}, nil
 }
 
-func (s *BookingService) ListBookings(ctx context.Context, req *v1.ListBookingsRequest) (*v1.ListBookingsReply, error) {
-	bookings, err := s.uc.List(ctx)
+func (s *BookingService) GetBookingHistory(ctx context.Context, req *v1.GetBookingHistoryRequest) (*v1.GetBookingHistoryReply, error) {
+	booking, err := s.uc.Get(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
-	return &v1.ListBookingsReply{Bookings: bookings}, nil
-}
-
-func (s *BookingService) UpdateBooking(ctx context.Context, req *v1.UpdateBookingRequest) (*v1.UpdateBookingReply, error) {
-	// 1️⃣ Fetch existing booking
-	booking, err := s.uc.Get(ctx, req.Id)
+	if err := checkHistoryAccess(ctx, booking); err != nil {
+		return nil, err
+	}
+	changes, err := s.uc.GetHistory(ctx, req.Id)
 	if err != nil {
-		return &v1.UpdateBookingReply{Success: false}, err
+		return nil, err
 	}
+	return &v1.GetBookingHistoryReply{Changes: statusChangesProto(changes)}, nil
+}
 
-	// 2️⃣ Update status
-	booking.Status = req.Status
-	updatedBooking, err := s.uc.Update(ctx, booking)
+// checkHistoryAccess lets the booking's owner and staff see its status
+// history, which names who moved it and why.
+func checkHistoryAccess(ctx context.Context, booking *v1.Booking) error {
+	if hasRole(ctx, roleStaff) {
+		return nil
+	}
+	userID, err := currentUserID(ctx)
 	if err != nil {
-		return &v1.UpdateBookingReply{Success: false}, err
+		return err
 	}
+	if booking.UserId != userID {
+		return v1.ErrorBookingNotOwned("booking %d does not belong to user %d", booking.Id, userID)
+	}
+	return nil
+}
 
-	eventID := updatedBooking.EventId
-	seatIDs := updatedBooking.SeatIds
-
-	switch updatedBooking.Status {
-	case "CONFIRMED":
-		// a) Decrement seats
-		_, err := s.eventClient.DecrementSeats(ctx, &eventv1.DecrementSeatsRequest{
-			EventId: eventID,
-			SeatIds: seatIDs,
+func statusChangesProto(changes []*biz.StatusChange) []*v1.BookingStatusChange {
+	res := make([]*v1.BookingStatusChange, 0, len(changes))
+	for _, c := range changes {
+		res = append(res, &v1.BookingStatusChange{
+			Id:         c.ID,
+			BookingId:  c.BookingID,
+			FromStatus: c.From,
+			ToStatus:   c.To,
+			Actor:      c.Actor,
+			ActorId:    c.ActorID,
+			Reason:     c.Reason,
+			ChangedAt:  c.ChangedAt.Format(time.RFC3339),
 		})
-		if err != nil {
-			s.log.Errorf("Failed to decrement seats: %v", err)
-			return &v1.UpdateBookingReply{Success: false}, err
-		}
+	}
+	return res
+}
 
-		// b) Send confirmation notification
-		go func(bookingID uint64) {
-			ctxNotif, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-
-			_, err := s.notificationClient.SendBookingNotification(ctxNotif, &notifv1.SendBookingNotificationRequest{
-				BookingId: bookingID, // notification service fetches email
-			})
-			if err != nil {
-				s.log.Errorf("Failed to send confirmation notification for booking %d: %v", bookingID, err)
-			} else {
-				s.log.Infof("Confirmation notification sent for booking %d", bookingID)
-			}
-		}(updatedBooking.Id)
-
-	case "CANCELLED":
-		// a) Increment seats
-		_, err := s.eventClient.IncrementSeats(ctx, &eventv1.IncrementSeatsRequest{
-			EventId: eventID,
-			SeatIds: seatIDs,
-		})
-		if err != nil {
-			s.log.Errorf("Failed to increment seats: %v", err)
-			return &v1.UpdateBookingReply{Success: false}, err
-		}
+func (s *BookingService) ListBookings(ctx context.Context, req *v1.ListBookingsRequest) (*v1.ListBookingsReply, error) {
+	filter, err := listFilter(req.EventId, req.Status, req.CreatedAfter, req.CreatedBefore, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	filter.UserID = req.UserId
+	bookings, next, err := s.uc.List(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListBookingsReply{Bookings: bookings, NextPageToken: next}, nil
+}
 
-		// b) Send cancellation notification
-		go func(bookingID uint64) {
-			ctxNotif, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
+func (s *BookingService) ListMyBookings(ctx context.Context, req *v1.ListMyBookingsRequest) (*v1.ListBookingsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := listFilter(req.EventId, req.Status, req.CreatedAfter, req.CreatedBefore, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	filter.UserID = userID
+	bookings, next, err := s.uc.List(ctx, filter, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListBookingsReply{Bookings: bookings, NextPageToken: next}, nil
+}
 
-			_, err := s.notificationClient.SendBookingNotification(ctxNotif, &notifv1.SendBookingNotificationRequest{
-				BookingId: bookingID,
-			})
-			if err != nil {
-				s.log.Errorf("Failed to send cancellation notification for booking %d: %v", bookingID, err)
-			} else {
-				s.log.Infof("Cancellation notification sent for booking %d", bookingID)
-			}
-		}(updatedBooking.Id)
+// listFilter builds the filter shared by ListBookings and ListMyBookings.
+func listFilter(eventID uint64, status v1.BookingStatus, createdAfter, createdBefore string, sort v1.BookingSort) (biz.BookingFilter, error) {
+	after, err := biz.ParseListTime("created_after", createdAfter)
+	if err != nil {
+		return biz.BookingFilter{}, err
+	}
+	before, err := biz.ParseListTime("created_before", createdBefore)
+	if err != nil {
+		return biz.BookingFilter{}, err
+	}
+	return biz.BookingFilter{
+		EventID:       eventID,
+		Status:        status,
+		CreatedAfter:  after,
+		CreatedBefore: before,
+		Sort:          sort,
+	}, nil
+}
+
+func (s *BookingService) UpdateBooking(ctx context.Context, req *v1.UpdateBookingRequest) (*v1.UpdateBookingReply, error) {
+	// Only paymentservice moves bookings this way, so the change is
+	// recorded against the payment it reports.
+	if err := requireService(ctx, "paymentservice"); err != nil {
+		return nil, err
+	}
+	// Move the booking to the new status; the usecase adjusts inventory,
+	// queues the notification in the outbox and records who made the move.
+	by := biz.ByPayment(req.PaymentId, req.Reason)
+	updatedBooking, err := s.uc.UpdateStatus(ctx, req.Id, req.Status, by)
+	if err != nil {
+		return &v1.UpdateBookingReply{Success: false}, err
 	}
 
 	s.log.Infof("Booking updated: Id=%d, Status=%s", updatedBooking.Id, updatedBooking.Status)
@@ -157,15 +203,53 @@ func (s *BookingService) UpdateBooking(ctx context.Context, req *v1.UpdateBookin
 
 
 func (s *BookingService) CancelBooking(ctx context.Context, req *v1.CancelBookingRequest) (*v1.CreateBookingReply, error) {
-	booking, err := s.uc.Cancel(ctx, req.Id)
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, err := s.uc.Cancel(ctx, req.Id, userID, req.Reason)
 	if err != nil {
 		return nil, err
 	}
 	return &v1.CreateBookingReply{Booking: booking}, nil
 }
 
+func (s *BookingService) CancelSeats(ctx context.Context, req *v1.CancelSeatsRequest) (*v1.CancelSeatsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, refund, err := s.uc.CancelSeats(ctx, req.Id, userID, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CancelSeatsReply{
+		Booking: booking,
+		Refund:  &moneyv1.Money{AmountMinor: refund, Currency: booking.GetTotalCost().GetCurrency()},
+	}, nil
+}
+
+func (s *BookingService) ChangeSeats(ctx context.Context, req *v1.ChangeSeatsRequest) (*v1.ChangeSeatsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, diff, err := s.uc.ChangeSeats(ctx, req.Id, userID, req.OldSeatIds, req.NewSeatIds, req.HoldToken)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ChangeSeatsReply{
+		Booking:         booking,
+		PriceDifference: &moneyv1.Money{AmountMinor: diff, Currency: booking.GetTotalCost().GetCurrency()},
+	}, nil
+}
+
 func (s *BookingService) ConfirmBooking(ctx context.Context, req *v1.ConfirmBookingRequest) (*v1.CreateBookingReply, error) {
-	booking, err := s.uc.ConfirmBooking(ctx, req.Id)
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, err := s.uc.ConfirmBooking(ctx, req.Id, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -186,32 +270,257 @@ func (s *BookingService) GetLockedSeats(ctx context.Context, req *v1.GetLockedSe
 	if err != nil {
 		return &v1.GetLockedSeatsReply{SeatIds: []string{}}, err
 	}
-	return &v1.GetLockedSeatsReply{SeatIds: lockedSeats}, nil
+	caller := biz.SeatHold{UserID: req.UserId, Token: req.HoldToken}
+	reply := &v1.GetLockedSeatsReply{
+		SeatIds: make([]string, 0, len(lockedSeats)),
+		Seats:   make([]*v1.LockedSeat, 0, len(lockedSeats)),
+	}
+	for _, seat := range lockedSeats {
+		reply.SeatIds = append(reply.SeatIds, seat.SeatID)
+		reply.Seats = append(reply.Seats, &v1.LockedSeat{
+			SeatId:        seat.SeatID,
+			ExpiresAt:     formatHoldExpiry(seat.ExpiresAt),
+			OwnedByCaller: req.UserId != 0 && seat.Owner.HeldBy(caller),
+		})
+	}
+	return reply, nil
 }
 
 func (s *BookingService) LockSeat(ctx context.Context, req *v1.LockSeatRequest) (*v1.LockSeatReply, error) {
-	var allLocked bool = true
-	for _, seatId := range req.SeatIds {
-		locked, err := s.uc.LockSeat(ctx, req.EventId, seatId, req.UserId)
-		if err != nil || !locked {
-			allLocked = false
-			// optional: log the seat that failed
-			continue
-		}
+	hold, err := s.uc.HoldSeats(ctx, req.EventId, req.SeatIds, biz.SeatHold{UserID: req.UserId}, req.AdmissionToken)
+	if err != nil {
+		return &v1.LockSeatReply{Locked: false}, err
+	}
+	if len(hold.Conflicts) > 0 {
+		s.log.Infof("LockSeat rejected: EventId=%d, UserId=%d, Conflicts=%v", req.EventId, req.UserId, hold.Conflicts)
+		return &v1.LockSeatReply{Locked: false, ConflictingSeatIds: hold.Conflicts}, nil
 	}
-	return &v1.LockSeatReply{Locked: allLocked}, nil
+	return &v1.LockSeatReply{
+		Locked:    true,
+		HoldToken: hold.Token,
+		ExpiresAt: formatHoldExpiry(hold.ExpiresAt),
+	}, nil
+}
+
+func (s *BookingService) ExtendSeatHold(ctx context.Context, req *v1.ExtendSeatHoldRequest) (*v1.ExtendSeatHoldReply, error) {
+	owner := biz.SeatHold{UserID: req.UserId, Token: req.HoldToken}
+	hold, err := s.uc.ExtendSeatHold(ctx, req.EventId, req.SeatIds, owner)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ExtendSeatHoldReply{
+		ExpiresAt:      formatHoldExpiry(hold.ExpiresAt),
+		ExtensionsLeft: s.uc.ExtensionsLeft(hold),
+	}, nil
 }
 
 func (s *BookingService) UnlockSeat(ctx context.Context, req *v1.UnlockSeatRequest) (*v1.UnlockSeatReply, error) {
-	var allSuccess bool = true
-	for _, seatId := range req.SeatIds {
-		err := s.uc.UnlockSeat(ctx, req.EventId, seatId, req.UserId)
-		if err != nil {
-			allSuccess = false
-			// optional: log the seat that failed
-			continue
-		}
+	owner := biz.SeatHold{UserID: req.UserId, Token: req.HoldToken}
+	if err := s.uc.UnlockSeats(ctx, req.EventId, req.SeatIds, owner); err != nil {
+		return &v1.UnlockSeatReply{Success: false}, err
+	}
+	return &v1.UnlockSeatReply{Success: true}, nil
+}
+
+// formatHoldExpiry renders a hold expiry as RFC3339 with milliseconds, so
+// clients can run an accurate countdown.
+func formatHoldExpiry(t time.Time) string {
+	return t.UTC().Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+// idempotencyKey reads the Idempotency-Key header (or gRPC metadata) of the
+// incoming request.
+func idempotencyKey(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("Idempotency-Key")
+	}
+	return ""
+}
+
+// ------------------- Waitlist -------------------
+func (s *BookingService) JoinWaitlist(ctx context.Context, req *v1.JoinWaitlistRequest) (*v1.WaitlistEntryReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.uc.JoinWaitlist(ctx, req.EventId, userID, req.SeatCount)
+	if err != nil {
+		return nil, err
+	}
+	return s.waitlistReply(ctx, entry)
+}
+
+func (s *BookingService) GetWaitlistEntry(ctx context.Context, req *v1.GetWaitlistEntryRequest) (*v1.WaitlistEntryReply, error) {
+	entry, err := s.uc.GetWaitlistEntry(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.waitlistReply(ctx, entry)
+}
+
+func (s *BookingService) LeaveWaitlist(ctx context.Context, req *v1.LeaveWaitlistRequest) (*v1.WaitlistEntryReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.uc.LeaveWaitlist(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.waitlistReply(ctx, entry)
+}
+
+func (s *BookingService) AcceptWaitlistOffer(ctx context.Context, req *v1.AcceptWaitlistOfferRequest) (*v1.CreateBookingReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, err := s.uc.AcceptWaitlistOffer(ctx, req.Id, userID, req.SeatIds)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateBookingReply{Booking: booking}, nil
+}
+
+func (s *BookingService) waitlistReply(ctx context.Context, entry *biz.WaitlistEntry) (*v1.WaitlistEntryReply, error) {
+	position, err := s.uc.WaitlistPosition(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.WaitlistEntry{
+		Id:        entry.ID,
+		EventId:   entry.EventID,
+		UserId:    entry.UserID,
+		SeatCount: entry.SeatCount,
+		State:     entry.State,
+		Position:  position,
+		BookingId: entry.BookingID,
+		CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+	}
+	if entry.State == v1.WaitlistState_WAITLIST_OFFERED {
+		reply.OfferExpiresAt = entry.OfferExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return &v1.WaitlistEntryReply{Entry: reply}, nil
+}
+
+// ------------------- Transfers -------------------
+func (s *BookingService) TransferBooking(ctx context.Context, req *v1.TransferBookingRequest) (*v1.TransferReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.uc.TransferBooking(ctx, req.Id, userID, req.RecipientEmail)
+	if err != nil {
+		return nil, err
 	}
-	return &v1.UnlockSeatReply{Success: allSuccess}, nil
+	return &v1.TransferReply{Transfer: transferProto(transfer)}, nil
 }
 
+func (s *BookingService) GetTransfer(ctx context.Context, req *v1.GetTransferRequest) (*v1.TransferReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.uc.GetTransfer(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TransferReply{Transfer: transferProto(transfer)}, nil
+}
+
+func (s *BookingService) AcceptTransfer(ctx context.Context, req *v1.AcceptTransferRequest) (*v1.TransferReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfer, booking, err := s.uc.AcceptTransfer(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TransferReply{Transfer: transferProto(transfer), Booking: booking}, nil
+}
+
+func (s *BookingService) CancelTransfer(ctx context.Context, req *v1.CancelTransferRequest) (*v1.TransferReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfer, err := s.uc.CancelTransfer(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TransferReply{Transfer: transferProto(transfer)}, nil
+}
+
+func (s *BookingService) ListBookingTransfers(ctx context.Context, req *v1.ListBookingTransfersRequest) (*v1.ListBookingTransfersReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transfers, err := s.uc.ListBookingTransfers(ctx, req.Id, userID)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListBookingTransfersReply{Transfers: make([]*v1.Transfer, 0, len(transfers))}
+	for _, t := range transfers {
+		reply.Transfers = append(reply.Transfers, transferProto(t))
+	}
+	return reply, nil
+}
+
+func transferProto(t *biz.Transfer) *v1.Transfer {
+	reply := &v1.Transfer{
+		Id:             t.ID,
+		BookingId:      t.BookingID,
+		FromUserId:     t.FromUserID,
+		ToUserId:       t.ToUserID,
+		RecipientEmail: t.RecipientEmail,
+		State:          t.State,
+		CreatedAt:      t.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:      t.ExpiresAt.Format(time.RFC3339),
+		ResolvedBy:     t.ResolvedBy,
+	}
+	if !t.ResolvedAt.IsZero() {
+		reply.ResolvedAt = t.ResolvedAt.Format(time.RFC3339)
+	}
+	return reply
+}
+
+// ------------------- Tickets -------------------
+func (s *BookingService) ListTickets(ctx context.Context, req *v1.ListTicketsRequest) (*v1.ListTicketsReply, error) {
+	userID, err := currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tickets, err := s.uc.ListTickets(ctx, req.Id, userID, req.IncludeVoid)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ListTicketsReply{Tickets: make([]*v1.Ticket, 0, len(tickets))}
+	for _, t := range tickets {
+		reply.Tickets = append(reply.Tickets, ticketProto(t))
+	}
+	return reply, nil
+}
+
+func ticketProto(t *biz.Ticket) *v1.Ticket {
+	reply := &v1.Ticket{
+		Id:        t.ID,
+		BookingId: t.BookingID,
+		EventId:   t.EventID,
+		SeatId:    t.SeatID,
+		Code:      t.Code,
+		Status:    t.Status,
+		Payload:   t.Payload,
+		KeyId:     t.KeyID,
+		IssuedAt:  t.IssuedAt.Format(time.RFC3339),
+	}
+	if !t.VoidedAt.IsZero() {
+		reply.VoidedAt = t.VoidedAt.Format(time.RFC3339)
+	}
+	if !t.CheckedInAt.IsZero() {
+		reply.CheckedInAt = t.CheckedInAt.Format(time.RFC3339)
+		reply.CheckedInGate = t.CheckedInGate
+	}
+	return reply
+}
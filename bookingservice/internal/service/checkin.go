@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+)
+
+type CheckInService struct {
+	v1.UnimplementedCheckInServiceServer
+	uc *biz.CheckInUsecase
+}
+
+func NewCheckInService(uc *biz.CheckInUsecase) *CheckInService {
+	return &CheckInService{uc: uc}
+}
+
+func (s *CheckInService) CheckIn(ctx context.Context, req *v1.CheckInRequest) (*v1.CheckInReply, error) {
+	if err := requireRole(ctx, roleStaff); err != nil {
+		return nil, err
+	}
+	ticket, err := s.uc.CheckIn(ctx, req.Code, req.Gate, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CheckInReply{Ticket: ticketProto(ticket)}, nil
+}
+
+func (s *CheckInService) GetAttendance(ctx context.Context, req *v1.GetAttendanceRequest) (*v1.GetAttendanceReply, error) {
+	if err := requireRole(ctx, roleStaff); err != nil {
+		return nil, err
+	}
+	a, err := s.uc.GetAttendance(ctx, req.EventId)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetAttendanceReply{
+		EventId:   a.EventID,
+		CheckedIn: a.CheckedIn,
+		Tickets:   a.Tickets,
+		ByGate:    a.ByGate,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+)
+
+type InventoryService struct {
+	v1.UnimplementedInventoryServiceServer
+	uc *biz.InventoryUsecase
+}
+
+func NewInventoryService(uc *biz.InventoryUsecase) *InventoryService {
+	return &InventoryService{uc: uc}
+}
+
+func (s *InventoryService) ReconcileInventory(ctx context.Context, req *v1.ReconcileInventoryRequest) (*v1.ReconcileInventoryReply, error) {
+	if err := requireRole(ctx, roleAdmin); err != nil {
+		return nil, err
+	}
+	report, err := s.uc.Reconcile(ctx, req.EventIds, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	reply := &v1.ReconcileInventoryReply{
+		Checked:   int32(report.Checked),
+		Repaired:  int32(report.Repaired),
+		Scheduled: int32(report.Scheduled),
+	}
+	for _, d := range report.Drifts {
+		reply.Drifts = append(reply.Drifts, &v1.InventoryDrift{
+			EventId:           d.EventID,
+			TotalSeats:        d.TotalSeats,
+			RecordedAvailable: d.RecordedAvailable,
+			ExpectedAvailable: d.ExpectedAvailable,
+			ConfirmedSeats:    d.ConfirmedSeats,
+			HeldSeats:         d.HeldSeats,
+			Drift:             d.Drift,
+			Repaired:          d.Repaired,
+			Skipped:           d.Skipped,
+			RepairScheduled:   d.Scheduled,
+		})
+	}
+	return reply, nil
+}
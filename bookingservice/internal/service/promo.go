@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+	moneyv1 "eventservice/api/money/v1"
+)
+
+type PromoService struct {
+	v1.UnimplementedPromoServiceServer
+	uc *biz.PromoUsecase
+}
+
+func NewPromoService(uc *biz.PromoUsecase) *PromoService {
+	return &PromoService{uc: uc}
+}
+
+func (s *PromoService) CreatePromoCode(ctx context.Context, req *v1.CreatePromoCodeRequest) (*v1.PromoCodeReply, error) {
+	if err := requireRole(ctx, roleAdmin); err != nil {
+		return nil, err
+	}
+	validFrom, err := parseOptionalTime("valid_from", req.ValidFrom)
+	if err != nil {
+		return nil, err
+	}
+	validUntil, err := parseOptionalTime("valid_until", req.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.uc.CreatePromoCode(ctx, &biz.PromoCode{
+		Code:           req.Code,
+		Type:           req.Type,
+		PercentOff:     req.PercentOff,
+		AmountOff:      req.GetAmountOff().GetAmountMinor(),
+		Currency:       req.GetAmountOff().GetCurrency(),
+		EventIDs:       req.EventIds,
+		ValidFrom:      validFrom,
+		ValidUntil:     validUntil,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PromoCodeReply{PromoCode: promoCodeProto(p)}, nil
+}
+
+func (s *PromoService) GetPromoCode(ctx context.Context, req *v1.GetPromoCodeRequest) (*v1.PromoCodeReply, error) {
+	p, err := s.uc.GetPromoCode(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PromoCodeReply{PromoCode: promoCodeProto(p)}, nil
+}
+
+// parseOptionalTime parses an RFC3339 request field; empty is the zero time.
+func parseOptionalTime(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC3339 time: %w", field, err)
+	}
+	return t, nil
+}
+
+func promoCodeProto(p *biz.PromoCode) *v1.PromoCode {
+	pc := &v1.PromoCode{
+		Id:             p.ID,
+		Code:           p.Code,
+		Type:           p.Type,
+		PercentOff:     p.PercentOff,
+		EventIds:       p.EventIDs,
+		MaxUses:        p.MaxUses,
+		MaxUsesPerUser: p.MaxUsesPerUser,
+		Uses:           p.Uses,
+		CreatedAt:      p.CreatedAt.Format(time.RFC3339),
+	}
+	if p.Type == v1.PromoDiscountType_AMOUNT_OFF {
+		pc.AmountOff = &moneyv1.Money{AmountMinor: p.AmountOff, Currency: p.Currency}
+	}
+	if !p.ValidFrom.IsZero() {
+		pc.ValidFrom = p.ValidFrom.Format(time.RFC3339)
+	}
+	if !p.ValidUntil.IsZero() {
+		pc.ValidUntil = p.ValidUntil.Format(time.RFC3339)
+	}
+	return pc
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// sseHeartbeat is how often an idle SSE stream gets a comment line, which
+// keeps proxies from closing it and notices clients that went away.
+const sseHeartbeat = 15 * time.Second
+
+func (s *BookingService) WatchSeats(req *v1.WatchSeatsRequest, stream grpc.ServerStreamingServer[v1.SeatUpdate]) error {
+	return s.uc.WatchSeats(stream.Context(), req.EventId, func(u *biz.SeatUpdate) error {
+		return stream.Send(seatUpdateProto(u))
+	})
+}
+
+// StreamSeats serves WatchSeats for one event as server-sent events, one
+// "seats" event per update with the SeatUpdate as JSON.
+func (s *BookingService) StreamSeats(w http.ResponseWriter, r *http.Request, eventID uint64) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ctx := r.Context()
+	updates := make(chan *biz.SeatUpdate)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.uc.WatchSeats(ctx, eventID, func(u *biz.SeatUpdate) error {
+			select {
+			case updates <- u:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-done:
+			if err != nil {
+				msg, _ := json.Marshal(err.Error())
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", msg)
+				flusher.Flush()
+			}
+			return
+		case u := <-updates:
+			data, err := marshal.Marshal(seatUpdateProto(u))
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: seats\ndata: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func seatUpdateProto(u *biz.SeatUpdate) *v1.SeatUpdate {
+	reply := &v1.SeatUpdate{
+		EventId:  u.EventID,
+		Kind:     u.Kind,
+		SeatIds:  u.SeatIDs,
+		At:       u.At.Format(time.RFC3339),
+		Snapshot: u.Snapshot,
+	}
+	if !u.ExpiresAt.IsZero() {
+		reply.ExpiresAt = u.ExpiresAt.Format(time.RFC3339)
+	}
+	return reply
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	v1 "bookingservice/api/bookingservice/v1"
+	"bookingservice/internal/biz"
+)
+
+type WaitingRoomService struct {
+	v1.UnimplementedWaitingRoomServiceServer
+	uc *biz.WaitingRoomUsecase
+}
+
+func NewWaitingRoomService(uc *biz.WaitingRoomUsecase) *WaitingRoomService {
+	return &WaitingRoomService{uc: uc}
+}
+
+func (s *WaitingRoomService) JoinQueue(ctx context.Context, req *v1.JoinQueueRequest) (*v1.QueueStatus, error) {
+	status, err := s.uc.Join(ctx, req.EventId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return queueStatusProto(status), nil
+}
+
+func (s *WaitingRoomService) GetQueueStatus(ctx context.Context, req *v1.GetQueueStatusRequest) (*v1.QueueStatus, error) {
+	status, err := s.uc.Status(ctx, req.EventId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return queueStatusProto(status), nil
+}
+
+func queueStatusProto(s *biz.QueueStatus) *v1.QueueStatus {
+	reply := &v1.QueueStatus{
+		EventId:              s.EventID,
+		UserId:               s.UserID,
+		Position:             s.Position,
+		EstimatedWaitSeconds: int64(s.EstimatedWait / time.Second),
+		Admitted:             s.Admitted,
+		AdmissionToken:       s.AdmissionToken,
+	}
+	if !s.TokenExpiresAt.IsZero() {
+		reply.TokenExpiresAt = s.TokenExpiresAt.UTC().Format(time.RFC3339)
+	}
+	return reply
+}
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	v1 "eventservice/api/money/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,16 +24,18 @@ const (
 )
 
 type ShowEvent struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Date           string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // ISO8601 string
-	TotalSeats     int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	AvailableSeats int32                  `protobuf:"varint,6,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	PricePerSeat   float32                `protobuf:"fixed32,7,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date            string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // ISO8601 string
+	TotalSeats      int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	AvailableSeats  int32                  `protobuf:"varint,6,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Price           *v1.Money              `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`                                                 // per seat
+	MaxSeatsPerUser int32                  `protobuf:"varint,9,opt,name=max_seats_per_user,json=maxSeatsPerUser,proto3" json:"max_seats_per_user,omitempty"` // seats one user may hold or book; 0 means bookingservice's default
+	WaitingRoom     bool                   `protobuf:"varint,10,opt,name=waiting_room,json=waitingRoom,proto3" json:"waiting_room,omitempty"`                // holds and bookings need an admission token from bookingservice's queue
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ShowEvent) Reset() {
@@ -107,22 +110,38 @@ func (x *ShowEvent) GetAvailableSeats() int32 {
 	return 0
 }
 
-func (x *ShowEvent) GetPricePerSeat() float32 {
+func (x *ShowEvent) GetPrice() *v1.Money {
 	if x != nil {
-		return x.PricePerSeat
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ShowEvent) GetMaxSeatsPerUser() int32 {
+	if x != nil {
+		return x.MaxSeatsPerUser
 	}
 	return 0
 }
 
+func (x *ShowEvent) GetWaitingRoom() bool {
+	if x != nil {
+		return x.WaitingRoom
+	}
+	return false
+}
+
 type CreateShowEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	PricePerSeat  float32                `protobuf:"fixed32,4,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
-	TotalSeats    int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Date            string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	TotalSeats      int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	Price           *v1.Money              `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`                                                 // per seat; the currency defaults to INR
+	MaxSeatsPerUser int32                  `protobuf:"varint,7,opt,name=max_seats_per_user,json=maxSeatsPerUser,proto3" json:"max_seats_per_user,omitempty"` // seats one user may hold or book; 0 means bookingservice's default
+	WaitingRoom     bool                   `protobuf:"varint,8,opt,name=waiting_room,json=waitingRoom,proto3" json:"waiting_room,omitempty"`                 // send visitors through bookingservice's waiting room
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateShowEventRequest) Reset() {
@@ -176,20 +195,34 @@ func (x *CreateShowEventRequest) GetDate() string {
 	return ""
 }
 
-func (x *CreateShowEventRequest) GetPricePerSeat() float32 {
+func (x *CreateShowEventRequest) GetTotalSeats() int32 {
 	if x != nil {
-		return x.PricePerSeat
+		return x.TotalSeats
 	}
 	return 0
 }
 
-func (x *CreateShowEventRequest) GetTotalSeats() int32 {
+func (x *CreateShowEventRequest) GetPrice() *v1.Money {
 	if x != nil {
-		return x.TotalSeats
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateShowEventRequest) GetMaxSeatsPerUser() int32 {
+	if x != nil {
+		return x.MaxSeatsPerUser
 	}
 	return 0
 }
 
+func (x *CreateShowEventRequest) GetWaitingRoom() bool {
+	if x != nil {
+		return x.WaitingRoom
+	}
+	return false
+}
+
 type ShowEventReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ShowEvent     *ShowEvent             `protobuf:"bytes,1,opt,name=show_event,json=showEvent,proto3" json:"show_event,omitempty"`
@@ -359,16 +392,18 @@ func (x *ListShowEventsReply) GetShowEvents() []*ShowEvent {
 }
 
 type UpdateShowEventRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Date           string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // ISO8601 string
-	TotalSeats     int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
-	AvailableSeats int32                  `protobuf:"varint,6,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	PricePerSeat   float32                `protobuf:"fixed32,7,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Date            string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"` // ISO8601 string
+	TotalSeats      int32                  `protobuf:"varint,5,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	AvailableSeats  int32                  `protobuf:"varint,6,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Price           *v1.Money              `protobuf:"bytes,8,opt,name=price,proto3" json:"price,omitempty"`
+	MaxSeatsPerUser int32                  `protobuf:"varint,9,opt,name=max_seats_per_user,json=maxSeatsPerUser,proto3" json:"max_seats_per_user,omitempty"` // seats one user may hold or book; 0 means bookingservice's default
+	WaitingRoom     bool                   `protobuf:"varint,10,opt,name=waiting_room,json=waitingRoom,proto3" json:"waiting_room,omitempty"`                // send visitors through bookingservice's waiting room
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateShowEventRequest) Reset() {
@@ -443,13 +478,27 @@ func (x *UpdateShowEventRequest) GetAvailableSeats() int32 {
 	return 0
 }
 
-func (x *UpdateShowEventRequest) GetPricePerSeat() float32 {
+func (x *UpdateShowEventRequest) GetPrice() *v1.Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateShowEventRequest) GetMaxSeatsPerUser() int32 {
 	if x != nil {
-		return x.PricePerSeat
+		return x.MaxSeatsPerUser
 	}
 	return 0
 }
 
+func (x *UpdateShowEventRequest) GetWaitingRoom() bool {
+	if x != nil {
+		return x.WaitingRoom
+	}
+	return false
+}
+
 type DeleteShowEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -638,6 +687,7 @@ type DecrementSeatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	OperationId   string                 `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"` // optional; an operation is applied at most once
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -686,6 +736,13 @@ func (x *DecrementSeatsRequest) GetSeatIds() []string {
 	return nil
 }
 
+func (x *DecrementSeatsRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
 type DecrementSeatsReply struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -750,6 +807,7 @@ type IncrementSeatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	SeatIds       []string               `protobuf:"bytes,2,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	OperationId   string                 `protobuf:"bytes,3,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"` // optional; an operation is applied at most once
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -798,6 +856,13 @@ func (x *IncrementSeatsRequest) GetSeatIds() []string {
 	return nil
 }
 
+func (x *IncrementSeatsRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
 type IncrementSeatsReply struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -850,11 +915,219 @@ func (x *IncrementSeatsReply) GetAvailableSeats() int32 {
 	return 0
 }
 
+type RevertSeatAdjustmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationId   string                 `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertSeatAdjustmentRequest) Reset() {
+	*x = RevertSeatAdjustmentRequest{}
+	mi := &file_eventservice_v1_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertSeatAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertSeatAdjustmentRequest) ProtoMessage() {}
+
+func (x *RevertSeatAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventservice_v1_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertSeatAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*RevertSeatAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_eventservice_v1_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevertSeatAdjustmentRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type RevertSeatAdjustmentReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reverted       bool                   `protobuf:"varint,1,opt,name=reverted,proto3" json:"reverted,omitempty"` // false if the adjustment had never been applied
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RevertSeatAdjustmentReply) Reset() {
+	*x = RevertSeatAdjustmentReply{}
+	mi := &file_eventservice_v1_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertSeatAdjustmentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertSeatAdjustmentReply) ProtoMessage() {}
+
+func (x *RevertSeatAdjustmentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_eventservice_v1_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertSeatAdjustmentReply.ProtoReflect.Descriptor instead.
+func (*RevertSeatAdjustmentReply) Descriptor() ([]byte, []int) {
+	return file_eventservice_v1_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RevertSeatAdjustmentReply) GetReverted() bool {
+	if x != nil {
+		return x.Reverted
+	}
+	return false
+}
+
+func (x *RevertSeatAdjustmentReply) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type SetAvailableSeatsRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	EventId                uint64                 `protobuf:"varint,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	ExpectedAvailableSeats int32                  `protobuf:"varint,2,opt,name=expected_available_seats,json=expectedAvailableSeats,proto3" json:"expected_available_seats,omitempty"` // the count the caller last saw
+	AvailableSeats         int32                  `protobuf:"varint,3,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SetAvailableSeatsRequest) Reset() {
+	*x = SetAvailableSeatsRequest{}
+	mi := &file_eventservice_v1_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvailableSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvailableSeatsRequest) ProtoMessage() {}
+
+func (x *SetAvailableSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_eventservice_v1_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvailableSeatsRequest.ProtoReflect.Descriptor instead.
+func (*SetAvailableSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_eventservice_v1_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetAvailableSeatsRequest) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *SetAvailableSeatsRequest) GetExpectedAvailableSeats() int32 {
+	if x != nil {
+		return x.ExpectedAvailableSeats
+	}
+	return 0
+}
+
+func (x *SetAvailableSeatsRequest) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type SetAvailableSeatsReply struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Updated        bool                   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"` // false if the count had moved on or was out of range
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetAvailableSeatsReply) Reset() {
+	*x = SetAvailableSeatsReply{}
+	mi := &file_eventservice_v1_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvailableSeatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvailableSeatsReply) ProtoMessage() {}
+
+func (x *SetAvailableSeatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_eventservice_v1_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvailableSeatsReply.ProtoReflect.Descriptor instead.
+func (*SetAvailableSeatsReply) Descriptor() ([]byte, []int) {
+	return file_eventservice_v1_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetAvailableSeatsReply) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *SetAvailableSeatsReply) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
 var File_eventservice_v1_event_proto protoreflect.FileDescriptor
 
 const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\n" +
-	"\x1beventservice/v1/event.proto\x12\bevent.v1\x1a\x1cgoogle/api/annotations.proto\"\xd7\x01\n" +
+	"\x1beventservice/v1/event.proto\x12\bevent.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x14money/v1/money.proto\"\xbe\x02\n" +
 	"\tShowEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -862,15 +1135,20 @@ const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1f\n" +
 	"\vtotal_seats\x18\x05 \x01(\x05R\n" +
 	"totalSeats\x12'\n" +
-	"\x0favailable_seats\x18\x06 \x01(\x05R\x0eavailableSeats\x12$\n" +
-	"\x0eprice_per_seat\x18\a \x01(\x02R\fpricePerSeat\"\xab\x01\n" +
+	"\x0favailable_seats\x18\x06 \x01(\x05R\x0eavailableSeats\x12%\n" +
+	"\x05price\x18\b \x01(\v2\x0f.money.v1.MoneyR\x05price\x12+\n" +
+	"\x12max_seats_per_user\x18\t \x01(\x05R\x0fmaxSeatsPerUser\x12!\n" +
+	"\fwaiting_room\x18\n" +
+	" \x01(\bR\vwaitingRoomJ\x04\b\a\x10\bR\x0eprice_per_seat\"\x92\x02\n" +
 	"\x16CreateShowEventRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
-	"\x04date\x18\x03 \x01(\tR\x04date\x12$\n" +
-	"\x0eprice_per_seat\x18\x04 \x01(\x02R\fpricePerSeat\x12\x1f\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x1f\n" +
 	"\vtotal_seats\x18\x05 \x01(\x05R\n" +
-	"totalSeats\"D\n" +
+	"totalSeats\x12%\n" +
+	"\x05price\x18\x06 \x01(\v2\x0f.money.v1.MoneyR\x05price\x12+\n" +
+	"\x12max_seats_per_user\x18\a \x01(\x05R\x0fmaxSeatsPerUser\x12!\n" +
+	"\fwaiting_room\x18\b \x01(\bR\vwaitingRoomJ\x04\b\x04\x10\x05R\x0eprice_per_seat\"D\n" +
 	"\x0eShowEventReply\x122\n" +
 	"\n" +
 	"show_event\x18\x01 \x01(\v2\x13.event.v1.ShowEventR\tshowEvent\"%\n" +
@@ -879,7 +1157,7 @@ const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\x15ListShowEventsRequest\"K\n" +
 	"\x13ListShowEventsReply\x124\n" +
 	"\vshow_events\x18\x01 \x03(\v2\x13.event.v1.ShowEventR\n" +
-	"showEvents\"\xe4\x01\n" +
+	"showEvents\"\xcb\x02\n" +
 	"\x16UpdateShowEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -887,8 +1165,11 @@ const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1f\n" +
 	"\vtotal_seats\x18\x05 \x01(\x05R\n" +
 	"totalSeats\x12'\n" +
-	"\x0favailable_seats\x18\x06 \x01(\x05R\x0eavailableSeats\x12$\n" +
-	"\x0eprice_per_seat\x18\a \x01(\x02R\fpricePerSeat\"(\n" +
+	"\x0favailable_seats\x18\x06 \x01(\x05R\x0eavailableSeats\x12%\n" +
+	"\x05price\x18\b \x01(\v2\x0f.money.v1.MoneyR\x05price\x12+\n" +
+	"\x12max_seats_per_user\x18\t \x01(\x05R\x0fmaxSeatsPerUser\x12!\n" +
+	"\fwaiting_room\x18\n" +
+	" \x01(\bR\vwaitingRoomJ\x04\b\a\x10\bR\x0eprice_per_seat\"(\n" +
 	"\x16DeleteShowEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"0\n" +
 	"\x14DeleteShowEventReply\x12\x18\n" +
@@ -897,20 +1178,34 @@ const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\"C\n" +
 	"\x11ValidateUserReply\x12\x14\n" +
 	"\x05found\x18\x01 \x01(\bR\x05found\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"M\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"p\n" +
 	"\x15DecrementSeatsRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x19\n" +
-	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\"r\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\tR\voperationId\"r\n" +
 	"\x13DecrementSeatsReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\x0favailable_seats\x18\x03 \x01(\x05R\x0eavailableSeats\"M\n" +
+	"\x0favailable_seats\x18\x03 \x01(\x05R\x0eavailableSeats\"p\n" +
 	"\x15IncrementSeatsRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x12\x19\n" +
-	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\"X\n" +
+	"\bseat_ids\x18\x02 \x03(\tR\aseatIds\x12!\n" +
+	"\foperation_id\x18\x03 \x01(\tR\voperationId\"X\n" +
 	"\x13IncrementSeatsReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
-	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats2\xfa\x06\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"@\n" +
+	"\x1bRevertSeatAdjustmentRequest\x12!\n" +
+	"\foperation_id\x18\x01 \x01(\tR\voperationId\"`\n" +
+	"\x19RevertSeatAdjustmentReply\x12\x1a\n" +
+	"\breverted\x18\x01 \x01(\bR\breverted\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"\x98\x01\n" +
+	"\x18SetAvailableSeatsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\x04R\aeventId\x128\n" +
+	"\x18expected_available_seats\x18\x02 \x01(\x05R\x16expectedAvailableSeats\x12'\n" +
+	"\x0favailable_seats\x18\x03 \x01(\x05R\x0eavailableSeats\"[\n" +
+	"\x16SetAvailableSeatsReply\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\bR\aupdated\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats2\x94\t\n" +
 	"\fEventService\x12f\n" +
 	"\x0fCreateShowEvent\x12 .event.v1.CreateShowEventRequest\x1a\x18.event.v1.ShowEventReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/show-events\x12b\n" +
 	"\fGetShowEvent\x12\x1d.event.v1.GetShowEventRequest\x1a\x18.event.v1.ShowEventReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/show-events/{id}\x12f\n" +
@@ -919,7 +1214,9 @@ const file_eventservice_v1_event_proto_rawDesc = "" +
 	"\x0fDeleteShowEvent\x12 .event.v1.DeleteShowEventRequest\x1a\x1e.event.v1.DeleteShowEventReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/show-events/{id}\x12g\n" +
 	"\fValidateUser\x12\x1d.event.v1.ValidateUserRequest\x1a\x1b.event.v1.ValidateUserReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/validate-user/{id}\x12w\n" +
 	"\x0eDecrementSeats\x12\x1f.event.v1.DecrementSeatsRequest\x1a\x1d.event.v1.DecrementSeatsReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/events/decrement-seats\x12w\n" +
-	"\x0eIncrementSeats\x12\x1f.event.v1.IncrementSeatsRequest\x1a\x1d.event.v1.IncrementSeatsReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/events/Increment-seatsB%Z#eventservice/api/eventservice/v1;v1b\x06proto3"
+	"\x0eIncrementSeats\x12\x1f.event.v1.IncrementSeatsRequest\x1a\x1d.event.v1.IncrementSeatsReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/events/Increment-seats\x12\x90\x01\n" +
+	"\x14RevertSeatAdjustment\x12%.event.v1.RevertSeatAdjustmentRequest\x1a#.event.v1.RevertSeatAdjustmentReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/events/revert-seat-adjustment\x12\x84\x01\n" +
+	"\x11SetAvailableSeats\x12\".event.v1.SetAvailableSeatsRequest\x1a .event.v1.SetAvailableSeatsReply\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/set-available-seatsB%Z#eventservice/api/eventservice/v1;v1b\x06proto3"
 
 var (
 	file_eventservice_v1_event_proto_rawDescOnce sync.Once
@@ -933,48 +1230,60 @@ func file_eventservice_v1_event_proto_rawDescGZIP() []byte {
 	return file_eventservice_v1_event_proto_rawDescData
 }
 
-var file_eventservice_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_eventservice_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_eventservice_v1_event_proto_goTypes = []any{
-	(*ShowEvent)(nil),              // 0: event.v1.ShowEvent
-	(*CreateShowEventRequest)(nil), // 1: event.v1.CreateShowEventRequest
-	(*ShowEventReply)(nil),         // 2: event.v1.ShowEventReply
-	(*GetShowEventRequest)(nil),    // 3: event.v1.GetShowEventRequest
-	(*ListShowEventsRequest)(nil),  // 4: event.v1.ListShowEventsRequest
-	(*ListShowEventsReply)(nil),    // 5: event.v1.ListShowEventsReply
-	(*UpdateShowEventRequest)(nil), // 6: event.v1.UpdateShowEventRequest
-	(*DeleteShowEventRequest)(nil), // 7: event.v1.DeleteShowEventRequest
-	(*DeleteShowEventReply)(nil),   // 8: event.v1.DeleteShowEventReply
-	(*ValidateUserRequest)(nil),    // 9: event.v1.ValidateUserRequest
-	(*ValidateUserReply)(nil),      // 10: event.v1.ValidateUserReply
-	(*DecrementSeatsRequest)(nil),  // 11: event.v1.DecrementSeatsRequest
-	(*DecrementSeatsReply)(nil),    // 12: event.v1.DecrementSeatsReply
-	(*IncrementSeatsRequest)(nil),  // 13: event.v1.IncrementSeatsRequest
-	(*IncrementSeatsReply)(nil),    // 14: event.v1.IncrementSeatsReply
+	(*ShowEvent)(nil),                   // 0: event.v1.ShowEvent
+	(*CreateShowEventRequest)(nil),      // 1: event.v1.CreateShowEventRequest
+	(*ShowEventReply)(nil),              // 2: event.v1.ShowEventReply
+	(*GetShowEventRequest)(nil),         // 3: event.v1.GetShowEventRequest
+	(*ListShowEventsRequest)(nil),       // 4: event.v1.ListShowEventsRequest
+	(*ListShowEventsReply)(nil),         // 5: event.v1.ListShowEventsReply
+	(*UpdateShowEventRequest)(nil),      // 6: event.v1.UpdateShowEventRequest
+	(*DeleteShowEventRequest)(nil),      // 7: event.v1.DeleteShowEventRequest
+	(*DeleteShowEventReply)(nil),        // 8: event.v1.DeleteShowEventReply
+	(*ValidateUserRequest)(nil),         // 9: event.v1.ValidateUserRequest
+	(*ValidateUserReply)(nil),           // 10: event.v1.ValidateUserReply
+	(*DecrementSeatsRequest)(nil),       // 11: event.v1.DecrementSeatsRequest
+	(*DecrementSeatsReply)(nil),         // 12: event.v1.DecrementSeatsReply
+	(*IncrementSeatsRequest)(nil),       // 13: event.v1.IncrementSeatsRequest
+	(*IncrementSeatsReply)(nil),         // 14: event.v1.IncrementSeatsReply
+	(*RevertSeatAdjustmentRequest)(nil), // 15: event.v1.RevertSeatAdjustmentRequest
+	(*RevertSeatAdjustmentReply)(nil),   // 16: event.v1.RevertSeatAdjustmentReply
+	(*SetAvailableSeatsRequest)(nil),    // 17: event.v1.SetAvailableSeatsRequest
+	(*SetAvailableSeatsReply)(nil),      // 18: event.v1.SetAvailableSeatsReply
+	(*v1.Money)(nil),                    // 19: money.v1.Money
 }
 var file_eventservice_v1_event_proto_depIdxs = []int32{
-	0,  // 0: event.v1.ShowEventReply.show_event:type_name -> event.v1.ShowEvent
-	0,  // 1: event.v1.ListShowEventsReply.show_events:type_name -> event.v1.ShowEvent
-	1,  // 2: event.v1.EventService.CreateShowEvent:input_type -> event.v1.CreateShowEventRequest
-	3,  // 3: event.v1.EventService.GetShowEvent:input_type -> event.v1.GetShowEventRequest
-	4,  // 4: event.v1.EventService.ListShowEvents:input_type -> event.v1.ListShowEventsRequest
-	6,  // 5: event.v1.EventService.UpdateShowEvent:input_type -> event.v1.UpdateShowEventRequest
-	7,  // 6: event.v1.EventService.DeleteShowEvent:input_type -> event.v1.DeleteShowEventRequest
-	9,  // 7: event.v1.EventService.ValidateUser:input_type -> event.v1.ValidateUserRequest
-	11, // 8: event.v1.EventService.DecrementSeats:input_type -> event.v1.DecrementSeatsRequest
-	13, // 9: event.v1.EventService.IncrementSeats:input_type -> event.v1.IncrementSeatsRequest
-	2,  // 10: event.v1.EventService.CreateShowEvent:output_type -> event.v1.ShowEventReply
-	2,  // 11: event.v1.EventService.GetShowEvent:output_type -> event.v1.ShowEventReply
-	5,  // 12: event.v1.EventService.ListShowEvents:output_type -> event.v1.ListShowEventsReply
-	2,  // 13: event.v1.EventService.UpdateShowEvent:output_type -> event.v1.ShowEventReply
-	8,  // 14: event.v1.EventService.DeleteShowEvent:output_type -> event.v1.DeleteShowEventReply
-	10, // 15: event.v1.EventService.ValidateUser:output_type -> event.v1.ValidateUserReply
-	12, // 16: event.v1.EventService.DecrementSeats:output_type -> event.v1.DecrementSeatsReply
-	14, // 17: event.v1.EventService.IncrementSeats:output_type -> event.v1.IncrementSeatsReply
-	10, // [10:18] is the sub-list for method output_type
-	2,  // [2:10] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	19, // 0: event.v1.ShowEvent.price:type_name -> money.v1.Money
+	19, // 1: event.v1.CreateShowEventRequest.price:type_name -> money.v1.Money
+	0,  // 2: event.v1.ShowEventReply.show_event:type_name -> event.v1.ShowEvent
+	0,  // 3: event.v1.ListShowEventsReply.show_events:type_name -> event.v1.ShowEvent
+	19, // 4: event.v1.UpdateShowEventRequest.price:type_name -> money.v1.Money
+	1,  // 5: event.v1.EventService.CreateShowEvent:input_type -> event.v1.CreateShowEventRequest
+	3,  // 6: event.v1.EventService.GetShowEvent:input_type -> event.v1.GetShowEventRequest
+	4,  // 7: event.v1.EventService.ListShowEvents:input_type -> event.v1.ListShowEventsRequest
+	6,  // 8: event.v1.EventService.UpdateShowEvent:input_type -> event.v1.UpdateShowEventRequest
+	7,  // 9: event.v1.EventService.DeleteShowEvent:input_type -> event.v1.DeleteShowEventRequest
+	9,  // 10: event.v1.EventService.ValidateUser:input_type -> event.v1.ValidateUserRequest
+	11, // 11: event.v1.EventService.DecrementSeats:input_type -> event.v1.DecrementSeatsRequest
+	13, // 12: event.v1.EventService.IncrementSeats:input_type -> event.v1.IncrementSeatsRequest
+	15, // 13: event.v1.EventService.RevertSeatAdjustment:input_type -> event.v1.RevertSeatAdjustmentRequest
+	17, // 14: event.v1.EventService.SetAvailableSeats:input_type -> event.v1.SetAvailableSeatsRequest
+	2,  // 15: event.v1.EventService.CreateShowEvent:output_type -> event.v1.ShowEventReply
+	2,  // 16: event.v1.EventService.GetShowEvent:output_type -> event.v1.ShowEventReply
+	5,  // 17: event.v1.EventService.ListShowEvents:output_type -> event.v1.ListShowEventsReply
+	2,  // 18: event.v1.EventService.UpdateShowEvent:output_type -> event.v1.ShowEventReply
+	8,  // 19: event.v1.EventService.DeleteShowEvent:output_type -> event.v1.DeleteShowEventReply
+	10, // 20: event.v1.EventService.ValidateUser:output_type -> event.v1.ValidateUserReply
+	12, // 21: event.v1.EventService.DecrementSeats:output_type -> event.v1.DecrementSeatsReply
+	14, // 22: event.v1.EventService.IncrementSeats:output_type -> event.v1.IncrementSeatsReply
+	16, // 23: event.v1.EventService.RevertSeatAdjustment:output_type -> event.v1.RevertSeatAdjustmentReply
+	18, // 24: event.v1.EventService.SetAvailableSeats:output_type -> event.v1.SetAvailableSeatsReply
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_eventservice_v1_event_proto_init() }
@@ -988,7 +1297,7 @@ func file_eventservice_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_eventservice_v1_event_proto_rawDesc), len(file_eventservice_v1_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_CreateShowEvent_FullMethodName = "/event.v1.EventService/CreateShowEvent"
-	EventService_GetShowEvent_FullMethodName    = "/event.v1.EventService/GetShowEvent"
-	EventService_ListShowEvents_FullMethodName  = "/event.v1.EventService/ListShowEvents"
-	EventService_UpdateShowEvent_FullMethodName = "/event.v1.EventService/UpdateShowEvent"
-	EventService_DeleteShowEvent_FullMethodName = "/event.v1.EventService/DeleteShowEvent"
-	EventService_ValidateUser_FullMethodName    = "/event.v1.EventService/ValidateUser"
-	EventService_DecrementSeats_FullMethodName  = "/event.v1.EventService/DecrementSeats"
-	EventService_IncrementSeats_FullMethodName  = "/event.v1.EventService/IncrementSeats"
+	EventService_CreateShowEvent_FullMethodName      = "/event.v1.EventService/CreateShowEvent"
+	EventService_GetShowEvent_FullMethodName         = "/event.v1.EventService/GetShowEvent"
+	EventService_ListShowEvents_FullMethodName       = "/event.v1.EventService/ListShowEvents"
+	EventService_UpdateShowEvent_FullMethodName      = "/event.v1.EventService/UpdateShowEvent"
+	EventService_DeleteShowEvent_FullMethodName      = "/event.v1.EventService/DeleteShowEvent"
+	EventService_ValidateUser_FullMethodName         = "/event.v1.EventService/ValidateUser"
+	EventService_DecrementSeats_FullMethodName       = "/event.v1.EventService/DecrementSeats"
+	EventService_IncrementSeats_FullMethodName       = "/event.v1.EventService/IncrementSeats"
+	EventService_RevertSeatAdjustment_FullMethodName = "/event.v1.EventService/RevertSeatAdjustment"
+	EventService_SetAvailableSeats_FullMethodName    = "/event.v1.EventService/SetAvailableSeats"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -42,6 +44,12 @@ type EventServiceClient interface {
 	ValidateUser(ctx context.Context, in *ValidateUserRequest, opts ...grpc.CallOption) (*ValidateUserReply, error)
 	DecrementSeats(ctx context.Context, in *DecrementSeatsRequest, opts ...grpc.CallOption) (*DecrementSeatsReply, error)
 	IncrementSeats(ctx context.Context, in *IncrementSeatsRequest, opts ...grpc.CallOption) (*IncrementSeatsReply, error)
+	// Undo a seat adjustment made with an operation_id. An adjustment that has
+	// not been applied yet is blocked from applying later.
+	RevertSeatAdjustment(ctx context.Context, in *RevertSeatAdjustmentRequest, opts ...grpc.CallOption) (*RevertSeatAdjustmentReply, error)
+	// Overwrite an event's available seats, only if they are still
+	// expected_available_seats. Used to repair drift from the bookings.
+	SetAvailableSeats(ctx context.Context, in *SetAvailableSeatsRequest, opts ...grpc.CallOption) (*SetAvailableSeatsReply, error)
 }
 
 type eventServiceClient struct {
@@ -132,6 +140,26 @@ func (c *eventServiceClient) IncrementSeats(ctx context.Context, in *IncrementSe
 	return out, nil
 }
 
+func (c *eventServiceClient) RevertSeatAdjustment(ctx context.Context, in *RevertSeatAdjustmentRequest, opts ...grpc.CallOption) (*RevertSeatAdjustmentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertSeatAdjustmentReply)
+	err := c.cc.Invoke(ctx, EventService_RevertSeatAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetAvailableSeats(ctx context.Context, in *SetAvailableSeatsRequest, opts ...grpc.CallOption) (*SetAvailableSeatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAvailableSeatsReply)
+	err := c.cc.Invoke(ctx, EventService_SetAvailableSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -145,6 +173,12 @@ type EventServiceServer interface {
 	ValidateUser(context.Context, *ValidateUserRequest) (*ValidateUserReply, error)
 	DecrementSeats(context.Context, *DecrementSeatsRequest) (*DecrementSeatsReply, error)
 	IncrementSeats(context.Context, *IncrementSeatsRequest) (*IncrementSeatsReply, error)
+	// Undo a seat adjustment made with an operation_id. An adjustment that has
+	// not been applied yet is blocked from applying later.
+	RevertSeatAdjustment(context.Context, *RevertSeatAdjustmentRequest) (*RevertSeatAdjustmentReply, error)
+	// Overwrite an event's available seats, only if they are still
+	// expected_available_seats. Used to repair drift from the bookings.
+	SetAvailableSeats(context.Context, *SetAvailableSeatsRequest) (*SetAvailableSeatsReply, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -179,6 +213,12 @@ func (UnimplementedEventServiceServer) DecrementSeats(context.Context, *Decremen
 func (UnimplementedEventServiceServer) IncrementSeats(context.Context, *IncrementSeatsRequest) (*IncrementSeatsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncrementSeats not implemented")
 }
+func (UnimplementedEventServiceServer) RevertSeatAdjustment(context.Context, *RevertSeatAdjustmentRequest) (*RevertSeatAdjustmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertSeatAdjustment not implemented")
+}
+func (UnimplementedEventServiceServer) SetAvailableSeats(context.Context, *SetAvailableSeatsRequest) (*SetAvailableSeatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvailableSeats not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -344,6 +384,42 @@ func _EventService_IncrementSeats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_RevertSeatAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertSeatAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RevertSeatAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RevertSeatAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RevertSeatAdjustment(ctx, req.(*RevertSeatAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetAvailableSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvailableSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetAvailableSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetAvailableSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetAvailableSeats(ctx, req.(*SetAvailableSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +459,14 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IncrementSeats",
 			Handler:    _EventService_IncrementSeats_Handler,
 		},
+		{
+			MethodName: "RevertSeatAdjustment",
+			Handler:    _EventService_RevertSeatAdjustment_Handler,
+		},
+		{
+			MethodName: "SetAvailableSeats",
+			Handler:    _EventService_SetAvailableSeats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "eventservice/v1/event.proto",
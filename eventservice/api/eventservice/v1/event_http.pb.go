@@ -25,6 +25,8 @@ const OperationEventServiceDeleteShowEvent = "/event.v1.EventService/DeleteShowE
 const OperationEventServiceGetShowEvent = "/event.v1.EventService/GetShowEvent"
 const OperationEventServiceIncrementSeats = "/event.v1.EventService/IncrementSeats"
 const OperationEventServiceListShowEvents = "/event.v1.EventService/ListShowEvents"
+const OperationEventServiceRevertSeatAdjustment = "/event.v1.EventService/RevertSeatAdjustment"
+const OperationEventServiceSetAvailableSeats = "/event.v1.EventService/SetAvailableSeats"
 const OperationEventServiceUpdateShowEvent = "/event.v1.EventService/UpdateShowEvent"
 const OperationEventServiceValidateUser = "/event.v1.EventService/ValidateUser"
 
@@ -36,6 +38,12 @@ type EventServiceHTTPServer interface {
 	GetShowEvent(context.Context, *GetShowEventRequest) (*ShowEventReply, error)
 	IncrementSeats(context.Context, *IncrementSeatsRequest) (*IncrementSeatsReply, error)
 	ListShowEvents(context.Context, *ListShowEventsRequest) (*ListShowEventsReply, error)
+	// RevertSeatAdjustment Undo a seat adjustment made with an operation_id. An adjustment that has
+	// not been applied yet is blocked from applying later.
+	RevertSeatAdjustment(context.Context, *RevertSeatAdjustmentRequest) (*RevertSeatAdjustmentReply, error)
+	// SetAvailableSeats Overwrite an event's available seats, only if they are still
+	// expected_available_seats. Used to repair drift from the bookings.
+	SetAvailableSeats(context.Context, *SetAvailableSeatsRequest) (*SetAvailableSeatsReply, error)
 	UpdateShowEvent(context.Context, *UpdateShowEventRequest) (*ShowEventReply, error)
 	ValidateUser(context.Context, *ValidateUserRequest) (*ValidateUserReply, error)
 }
@@ -50,6 +58,8 @@ func RegisterEventServiceHTTPServer(s *http.Server, srv EventServiceHTTPServer)
 	r.GET("/validate-user/{id}", _EventService_ValidateUser0_HTTP_Handler(srv))
 	r.POST("/v1/events/decrement-seats", _EventService_DecrementSeats0_HTTP_Handler(srv))
 	r.POST("/v1/events/Increment-seats", _EventService_IncrementSeats0_HTTP_Handler(srv))
+	r.POST("/v1/events/revert-seat-adjustment", _EventService_RevertSeatAdjustment0_HTTP_Handler(srv))
+	r.POST("/v1/events/set-available-seats", _EventService_SetAvailableSeats0_HTTP_Handler(srv))
 }
 
 func _EventService_CreateShowEvent0_HTTP_Handler(srv EventServiceHTTPServer) func(ctx http.Context) error {
@@ -228,6 +238,50 @@ func _EventService_IncrementSeats0_HTTP_Handler(srv EventServiceHTTPServer) func
 	}
 }
 
+func _EventService_RevertSeatAdjustment0_HTTP_Handler(srv EventServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevertSeatAdjustmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventServiceRevertSeatAdjustment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevertSeatAdjustment(ctx, req.(*RevertSeatAdjustmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevertSeatAdjustmentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _EventService_SetAvailableSeats0_HTTP_Handler(srv EventServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetAvailableSeatsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationEventServiceSetAvailableSeats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetAvailableSeats(ctx, req.(*SetAvailableSeatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SetAvailableSeatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type EventServiceHTTPClient interface {
 	// CreateShowEvent ---------------- ShowEvent CRUD ----------------
 	CreateShowEvent(ctx context.Context, req *CreateShowEventRequest, opts ...http.CallOption) (rsp *ShowEventReply, err error)
@@ -236,6 +290,12 @@ type EventServiceHTTPClient interface {
 	GetShowEvent(ctx context.Context, req *GetShowEventRequest, opts ...http.CallOption) (rsp *ShowEventReply, err error)
 	IncrementSeats(ctx context.Context, req *IncrementSeatsRequest, opts ...http.CallOption) (rsp *IncrementSeatsReply, err error)
 	ListShowEvents(ctx context.Context, req *ListShowEventsRequest, opts ...http.CallOption) (rsp *ListShowEventsReply, err error)
+	// RevertSeatAdjustment Undo a seat adjustment made with an operation_id. An adjustment that has
+	// not been applied yet is blocked from applying later.
+	RevertSeatAdjustment(ctx context.Context, req *RevertSeatAdjustmentRequest, opts ...http.CallOption) (rsp *RevertSeatAdjustmentReply, err error)
+	// SetAvailableSeats Overwrite an event's available seats, only if they are still
+	// expected_available_seats. Used to repair drift from the bookings.
+	SetAvailableSeats(ctx context.Context, req *SetAvailableSeatsRequest, opts ...http.CallOption) (rsp *SetAvailableSeatsReply, err error)
 	UpdateShowEvent(ctx context.Context, req *UpdateShowEventRequest, opts ...http.CallOption) (rsp *ShowEventReply, err error)
 	ValidateUser(ctx context.Context, req *ValidateUserRequest, opts ...http.CallOption) (rsp *ValidateUserReply, err error)
 }
@@ -327,6 +387,36 @@ func (c *EventServiceHTTPClientImpl) ListShowEvents(ctx context.Context, in *Lis
 	return &out, nil
 }
 
+// RevertSeatAdjustment Undo a seat adjustment made with an operation_id. An adjustment that has
+// not been applied yet is blocked from applying later.
+func (c *EventServiceHTTPClientImpl) RevertSeatAdjustment(ctx context.Context, in *RevertSeatAdjustmentRequest, opts ...http.CallOption) (*RevertSeatAdjustmentReply, error) {
+	var out RevertSeatAdjustmentReply
+	pattern := "/v1/events/revert-seat-adjustment"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventServiceRevertSeatAdjustment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetAvailableSeats Overwrite an event's available seats, only if they are still
+// expected_available_seats. Used to repair drift from the bookings.
+func (c *EventServiceHTTPClientImpl) SetAvailableSeats(ctx context.Context, in *SetAvailableSeatsRequest, opts ...http.CallOption) (*SetAvailableSeatsReply, error) {
+	var out SetAvailableSeatsReply
+	pattern := "/v1/events/set-available-seats"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationEventServiceSetAvailableSeats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *EventServiceHTTPClientImpl) UpdateShowEvent(ctx context.Context, in *UpdateShowEventRequest, opts ...http.CallOption) (*ShowEventReply, error) {
 	var out ShowEventReply
 	pattern := "/show-events/{id}"
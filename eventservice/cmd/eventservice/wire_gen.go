@@ -28,7 +28,11 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 	if err != nil {
 		return nil, nil, err
 	}
-	showEventRepo := data.NewShowEventRepo(db)
+	showEventRepo, err := data.NewShowEventRepo(db, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	userServiceClient, cleanup2, err := data.ProvideUserClient()
 	if err != nil {
 		cleanup()
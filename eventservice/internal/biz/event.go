@@ -2,12 +2,14 @@ package biz
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	userv1 "userservice/api/userservice/v1"
 	eventv1 "eventservice/api/eventservice/v1"
+	moneyv1 "eventservice/api/money/v1"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
@@ -54,13 +56,15 @@ func parseDate(dateStr string) time.Time {
 
 func toProto(ev *ShowEvent) *eventv1.ShowEvent {
 	return &eventv1.ShowEvent{
-		Id:             ev.ID,
-		Title:          ev.Title,
-		Description:    ev.Description,
-		Date:           ev.Date.Format(time.RFC3339),
-		TotalSeats:     ev.TotalSeats,
-		AvailableSeats: ev.AvailableSeats,
-		PricePerSeat:   ev.PricePerSeat,
+		Id:              ev.ID,
+		Title:           ev.Title,
+		Description:     ev.Description,
+		Date:            ev.Date.Format(time.RFC3339),
+		TotalSeats:      ev.TotalSeats,
+		AvailableSeats:  ev.AvailableSeats,
+		Price:           &moneyv1.Money{AmountMinor: ev.PriceMinor, Currency: ev.Currency},
+		MaxSeatsPerUser: ev.MaxSeatsPerUser,
+		WaitingRoom:     ev.WaitingRoom,
 	}
 }
 
@@ -72,7 +76,14 @@ type ShowEvent struct {
 	Date           time.Time
 	TotalSeats     int32
 	AvailableSeats int32
-	PricePerSeat   float32
+	// PriceMinor is the price of a seat in minor units of Currency.
+	PriceMinor int64
+	Currency   string
+	// MaxSeatsPerUser caps the seats one user may hold or book; 0 leaves it
+	// to bookingservice's default.
+	MaxSeatsPerUser int32
+	// WaitingRoom sends visitors through bookingservice's queue first.
+	WaitingRoom bool
 }
 
 // ---------------- Repo Interface ----------------
@@ -82,8 +93,30 @@ type ShowEventRepo interface {
 	List(ctx context.Context) ([]*ShowEvent, error)
 	Update(ctx context.Context, ev *ShowEvent) (*ShowEvent, error)
 	Delete(ctx context.Context, id uint64) (bool, error)
+	// AdjustSeats changes an event's available seats by delta, keeping them
+	// between 0 and the total, and returns the new count. A decrement fails
+	// with ErrNotEnoughSeats rather than going below 0. With an operationID
+	// the change is applied at most once; repeats succeed without applying
+	// it again, and a reverted operation fails with ErrAdjustmentReverted.
+	AdjustSeats(ctx context.Context, eventID uint64, delta int32, operationID string) (int32, error)
+	// RevertAdjustment undoes the adjustment made under operationID and
+	// reports whether there was one to undo. If there was not, the
+	// operation is blocked from being applied later.
+	RevertAdjustment(ctx context.Context, operationID string) (int32, bool, error)
+	// SetAvailableSeats sets an event's available seats to `to` only if they
+	// are still `from` and `to` is within the total, and returns the count it
+	// left along with whether it changed it.
+	SetAvailableSeats(ctx context.Context, eventID uint64, from, to int32) (int32, bool, error)
 }
 
+// DefaultCurrency is the currency of an event created without one.
+const DefaultCurrency = "INR"
+
+var (
+	ErrNotEnoughSeats     = errors.New("not enough seats available")
+	ErrAdjustmentReverted = errors.New("seat adjustment was reverted")
+)
+
 // ---------------- Usecase ----------------
 type ShowEventUsecase struct {
 	repo       ShowEventRepo
@@ -101,19 +134,31 @@ func NewShowEventUsecase(repo ShowEventRepo, userClient userv1.UserServiceClient
 
 // Create event
 func (uc *ShowEventUsecase) Create(ctx context.Context, req *eventv1.CreateShowEventRequest) (*ShowEvent, error) {
+	if req.GetPrice().GetAmountMinor() < 0 {
+		return nil, fmt.Errorf("price cannot be negative")
+	}
+	if req.MaxSeatsPerUser < 0 {
+		return nil, fmt.Errorf("max_seats_per_user cannot be negative")
+	}
+	if req.GetPrice().GetCurrency() == "" {
+		req.Price = &moneyv1.Money{AmountMinor: req.GetPrice().GetAmountMinor(), Currency: DefaultCurrency}
+	}
 	protoEv, err := uc.repo.Create(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	ev := &ShowEvent{
-		ID:             protoEv.Id,
-		Title:          protoEv.Title,
-		Description:    protoEv.Description,
-		Date:           parseDate(protoEv.Date),
-		TotalSeats:     protoEv.TotalSeats,
-		AvailableSeats: protoEv.AvailableSeats,
-		PricePerSeat:   protoEv.PricePerSeat,
+		ID:              protoEv.Id,
+		Title:           protoEv.Title,
+		Description:     protoEv.Description,
+		Date:            parseDate(protoEv.Date),
+		TotalSeats:      protoEv.TotalSeats,
+		AvailableSeats:  protoEv.AvailableSeats,
+		PriceMinor:      protoEv.GetPrice().GetAmountMinor(),
+		Currency:        protoEv.GetPrice().GetCurrency(),
+		MaxSeatsPerUser: protoEv.MaxSeatsPerUser,
+		WaitingRoom:     protoEv.WaitingRoom,
 	}
 
 	return ev, nil
@@ -131,6 +176,9 @@ func (uc *ShowEventUsecase) List(ctx context.Context) ([]*ShowEvent, error) {
 
 // Update event
 func (uc *ShowEventUsecase) Update(ctx context.Context, ev *ShowEvent) (*ShowEvent, error) {
+	if ev.MaxSeatsPerUser < 0 {
+		return nil, fmt.Errorf("max_seats_per_user cannot be negative")
+	}
 	return uc.repo.Update(ctx, ev)
 }
 
@@ -141,42 +189,49 @@ func (uc *ShowEventUsecase) Delete(ctx context.Context, id uint64) (bool, error)
 
 // ---------------- Seat Management -------------------
 
-func (uc *ShowEventUsecase) DecrementSeats(ctx context.Context, eventID uint64, seatIDs []string) error {
-	ev, err := uc.repo.Get(ctx, eventID)
+func (uc *ShowEventUsecase) DecrementSeats(ctx context.Context, eventID uint64, seatIDs []string, operationID string) (int32, error) {
+	available, err := uc.repo.AdjustSeats(ctx, eventID, -int32(len(seatIDs)), operationID)
 	if err != nil {
-		return fmt.Errorf("event not found: %w", err)
-	}
-
-	if ev.AvailableSeats < int32(len(seatIDs)) {
-		return fmt.Errorf("not enough seats available")
+		return 0, err
 	}
+	uc.log.Infof("EventID=%d: seats decremented, AvailableSeats=%d", eventID, available)
+	return available, nil
+}
 
-	ev.AvailableSeats -= int32(len(seatIDs))
-	_, err = uc.repo.Update(ctx, ev)
+func (uc *ShowEventUsecase) IncrementSeats(ctx context.Context, eventID uint64, seatIDs []string, operationID string) (int32, error) {
+	available, err := uc.repo.AdjustSeats(ctx, eventID, int32(len(seatIDs)), operationID)
 	if err != nil {
-		return fmt.Errorf("failed to update event: %w", err)
+		return 0, err
 	}
-
-	uc.log.Infof("EventID=%d: seats decremented, AvailableSeats=%d", eventID, ev.AvailableSeats)
-	return nil
+	uc.log.Infof("EventID=%d: seats incremented, AvailableSeats=%d", eventID, available)
+	return available, nil
 }
 
-func (uc *ShowEventUsecase) IncrementSeats(ctx context.Context, eventID uint64, seatIDs []string) error {
-	ev, err := uc.repo.Get(ctx, eventID)
+// RevertSeatAdjustment undoes an earlier Decrement/IncrementSeats call made
+// with operationID.
+func (uc *ShowEventUsecase) RevertSeatAdjustment(ctx context.Context, operationID string) (int32, bool, error) {
+	if operationID == "" {
+		return 0, false, fmt.Errorf("operation_id is required")
+	}
+	available, reverted, err := uc.repo.RevertAdjustment(ctx, operationID)
 	if err != nil {
-		return fmt.Errorf("event not found: %w", err)
+		return 0, false, err
 	}
+	uc.log.Infof("Seat adjustment %s reverted=%t, AvailableSeats=%d", operationID, reverted, available)
+	return available, reverted, nil
+}
 
-	ev.AvailableSeats += int32(len(seatIDs))
-	if ev.AvailableSeats > ev.TotalSeats {
-		ev.AvailableSeats = ev.TotalSeats
+// SetAvailableSeats overwrites an event's available seat count, as long as
+// nothing adjusted it since the caller read expected. It is how inventory
+// drift is repaired.
+func (uc *ShowEventUsecase) SetAvailableSeats(ctx context.Context, eventID uint64, expected, available int32) (int32, bool, error) {
+	if available < 0 {
+		return 0, false, fmt.Errorf("available_seats cannot be negative")
 	}
-
-	_, err = uc.repo.Update(ctx, ev)
+	current, updated, err := uc.repo.SetAvailableSeats(ctx, eventID, expected, available)
 	if err != nil {
-		return fmt.Errorf("failed to update event: %w", err)
+		return 0, false, err
 	}
-
-	uc.log.Infof("EventID=%d: seats incremented, AvailableSeats=%d", eventID, ev.AvailableSeats)
-	return nil
+	uc.log.Infof("EventID=%d: available seats set from %d to %d, updated=%t, AvailableSeats=%d", eventID, expected, available, updated, current)
+	return current, updated, nil
 }
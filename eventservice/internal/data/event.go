@@ -7,7 +7,11 @@ import (
 	"time"
 
 	eventv1 "eventservice/api/eventservice/v1"
+	moneyv1 "eventservice/api/money/v1"
 	"eventservice/internal/biz"
+	"eventservice/pkg/money"
+
+	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
@@ -50,36 +54,56 @@ func parseDate(dateStr string) time.Time {
 	return time.Now()
 }
 
-
 type ShowEvent struct {
-	ID             uint64    `gorm:"primaryKey"`
+	ID             uint64 `gorm:"primaryKey"`
 	Title          string
 	Description    string
 	Date           time.Time
 	TotalSeats     int32
-	AvailableSeats int32 `gorm:"column:available_seats"`
-	PricePerSeat   float32
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	AvailableSeats int32  `gorm:"column:available_seats"`
+	PriceMinor     int64  `gorm:"not null;default:0"`
+	Currency       string `gorm:"size:3;not null;default:'INR'"`
+	// MaxSeatsPerUser caps the seats one user may hold or book; 0 leaves
+	// it to bookingservice's default.
+	MaxSeatsPerUser int32 `gorm:"not null;default:0"`
+	// WaitingRoom sends visitors through bookingservice's queue before they
+	// can hold or book seats.
+	WaitingRoom bool `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 type showEventRepo struct {
 	db *gorm.DB
 }
 
-func NewShowEventRepo(db *gorm.DB) biz.ShowEventRepo {
-	db.AutoMigrate(&ShowEvent{})
-	return &showEventRepo{db: db}
+func NewShowEventRepo(db *gorm.DB, logger log.Logger) (biz.ShowEventRepo, error) {
+	if err := db.AutoMigrate(&ShowEvent{}, &SeatAdjustment{}); err != nil {
+		return nil, fmt.Errorf("failed to migrate events: %w", err)
+	}
+
+	// Move prices of older events over to minor units
+	moved, err := money.MigrateMinorUnits(db, "show_events", "price_per_seat", "price_minor")
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill event prices: %w", err)
+	}
+	if moved > 0 {
+		log.NewHelper(logger).Infof("Backfilled price_minor for %d events", moved)
+	}
+	return &showEventRepo{db: db}, nil
 }
 
 func (r *showEventRepo) Create(ctx context.Context, req *eventv1.CreateShowEventRequest) (*eventv1.ShowEvent, error) {
 	ev := &ShowEvent{
-		Title:          req.Title,
-		Description:    req.Description,
-		Date:           parseDate(req.Date),
-		TotalSeats:     req.TotalSeats,
-		AvailableSeats: req.TotalSeats,
-		PricePerSeat:   req.PricePerSeat,
+		Title:           req.Title,
+		Description:     req.Description,
+		Date:            parseDate(req.Date),
+		TotalSeats:      req.TotalSeats,
+		AvailableSeats:  req.TotalSeats,
+		PriceMinor:      req.GetPrice().GetAmountMinor(),
+		Currency:        req.GetPrice().GetCurrency(),
+		MaxSeatsPerUser: req.MaxSeatsPerUser,
+		WaitingRoom:     req.WaitingRoom,
 	}
 
 	if err := r.db.WithContext(ctx).Create(ev).Error; err != nil {
@@ -87,13 +111,15 @@ func (r *showEventRepo) Create(ctx context.Context, req *eventv1.CreateShowEvent
 	}
 
 	return &eventv1.ShowEvent{
-		Id:             ev.ID,
-		Title:          ev.Title,
-		Description:    ev.Description,
-		Date:           ev.Date.Format(time.RFC3339),
-		TotalSeats:     ev.TotalSeats,
-		AvailableSeats: ev.AvailableSeats,
-		PricePerSeat:   ev.PricePerSeat,
+		Id:              ev.ID,
+		Title:           ev.Title,
+		Description:     ev.Description,
+		Date:            ev.Date.Format(time.RFC3339),
+		TotalSeats:      ev.TotalSeats,
+		AvailableSeats:  ev.AvailableSeats,
+		Price:           &moneyv1.Money{AmountMinor: ev.PriceMinor, Currency: ev.Currency},
+		MaxSeatsPerUser: ev.MaxSeatsPerUser,
+		WaitingRoom:     ev.WaitingRoom,
 	}, nil
 }
 
@@ -103,13 +129,16 @@ func (r *showEventRepo) Get(ctx context.Context, id uint64) (*biz.ShowEvent, err
 		return nil, err
 	}
 	return &biz.ShowEvent{
-		ID:             model.ID,
-		Title:          model.Title,
-		Description:    model.Description,
-		Date:           model.Date,
-		TotalSeats:     model.TotalSeats,
-		AvailableSeats: model.AvailableSeats,
-		PricePerSeat:   model.PricePerSeat,
+		ID:              model.ID,
+		Title:           model.Title,
+		Description:     model.Description,
+		Date:            model.Date,
+		TotalSeats:      model.TotalSeats,
+		AvailableSeats:  model.AvailableSeats,
+		PriceMinor:      model.PriceMinor,
+		Currency:        model.Currency,
+		MaxSeatsPerUser: model.MaxSeatsPerUser,
+		WaitingRoom:     model.WaitingRoom,
 	}, nil
 }
 
@@ -122,13 +151,16 @@ func (r *showEventRepo) List(ctx context.Context) ([]*biz.ShowEvent, error) {
 	res := make([]*biz.ShowEvent, 0, len(models))
 	for _, m := range models {
 		res = append(res, &biz.ShowEvent{
-			ID:             m.ID,
-			Title:          m.Title,
-			Description:    m.Description,
-			Date:           m.Date,
-			TotalSeats:     m.TotalSeats,
-			AvailableSeats: m.AvailableSeats,
-			PricePerSeat:   m.PricePerSeat,
+			ID:              m.ID,
+			Title:           m.Title,
+			Description:     m.Description,
+			Date:            m.Date,
+			TotalSeats:      m.TotalSeats,
+			AvailableSeats:  m.AvailableSeats,
+			PriceMinor:      m.PriceMinor,
+			Currency:        m.Currency,
+			MaxSeatsPerUser: m.MaxSeatsPerUser,
+			WaitingRoom:     m.WaitingRoom,
 		})
 	}
 	return res, nil
@@ -141,20 +173,24 @@ func (r *showEventRepo) Update(ctx context.Context, ev *biz.ShowEvent) (*biz.Sho
 	}
 
 	dbEv.AvailableSeats = ev.AvailableSeats
-	fmt.Printf("Updating DB: EventID=%d NewAvailable=%d\n", ev.ID, ev.AvailableSeats)
+	dbEv.MaxSeatsPerUser = ev.MaxSeatsPerUser
+	dbEv.WaitingRoom = ev.WaitingRoom
 
 	if err := r.db.Save(&dbEv).Error; err != nil {
 		return nil, err
 	}
 
 	return &biz.ShowEvent{
-		ID:             dbEv.ID,
-		Title:          dbEv.Title,
-		Description:    dbEv.Description,
-		Date:           dbEv.Date,
-		TotalSeats:     dbEv.TotalSeats,
-		AvailableSeats: dbEv.AvailableSeats,
-		PricePerSeat:   dbEv.PricePerSeat,
+		ID:              dbEv.ID,
+		Title:           dbEv.Title,
+		Description:     dbEv.Description,
+		Date:            dbEv.Date,
+		TotalSeats:      dbEv.TotalSeats,
+		AvailableSeats:  dbEv.AvailableSeats,
+		PriceMinor:      dbEv.PriceMinor,
+		Currency:        dbEv.Currency,
+		MaxSeatsPerUser: dbEv.MaxSeatsPerUser,
+		WaitingRoom:     dbEv.WaitingRoom,
 	}, nil
 }
 
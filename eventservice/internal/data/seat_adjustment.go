@@ -0,0 +1,142 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"eventservice/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SeatAdjustment DB model, one row per Decrement/IncrementSeats operation
+// made with an operation id. A row with Reverted set and no delta is a
+// tombstone for an operation that was reverted before it was applied.
+type SeatAdjustment struct {
+	OperationID string `gorm:"primaryKey;size:128"`
+	EventID     uint64 `gorm:"index"`
+	Delta       int32
+	Reverted    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// clampedSeats adds delta to available_seats, keeping it within 0..total_seats.
+func clampedSeats(delta int32) clause.Expr {
+	return gorm.Expr("LEAST(GREATEST(available_seats + ?, 0), total_seats)", delta)
+}
+
+func availableSeats(tx *gorm.DB, eventID uint64) (int32, error) {
+	var ev ShowEvent
+	if err := tx.Select("available_seats").First(&ev, eventID).Error; err != nil {
+		return 0, err
+	}
+	return ev.AvailableSeats, nil
+}
+
+func (r *showEventRepo) AdjustSeats(ctx context.Context, eventID uint64, delta int32, operationID string) (int32, error) {
+	var available int32
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if operationID != "" {
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&SeatAdjustment{
+				OperationID: operationID,
+				EventID:     eventID,
+				Delta:       delta,
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				// Seen before: a repeat of an applied operation, or a reverted one
+				var adj SeatAdjustment
+				if err := tx.First(&adj, "operation_id = ?", operationID).Error; err != nil {
+					return err
+				}
+				if adj.Reverted {
+					return biz.ErrAdjustmentReverted
+				}
+				var err error
+				available, err = availableSeats(tx, eventID)
+				return err
+			}
+		}
+
+		q := tx.Model(&ShowEvent{}).Where("id = ?", eventID)
+		if delta < 0 {
+			q = q.Where("available_seats >= ?", -delta)
+		}
+		res := q.Update("available_seats", clampedSeats(delta))
+		if res.Error != nil {
+			return res.Error
+		}
+		var err error
+		available, err = availableSeats(tx, eventID)
+		if err != nil {
+			return err
+		}
+		if res.RowsAffected == 0 {
+			return biz.ErrNotEnoughSeats
+		}
+		return nil
+	})
+	return available, err
+}
+
+func (r *showEventRepo) RevertAdjustment(ctx context.Context, operationID string) (int32, bool, error) {
+	var (
+		available int32
+		reverted  bool
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var adj SeatAdjustment
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&adj, "operation_id = ?", operationID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Never applied; leave a tombstone so that it never will be
+			return tx.Create(&SeatAdjustment{OperationID: operationID, Reverted: true}).Error
+		}
+		if err != nil {
+			return err
+		}
+		if adj.Reverted {
+			reverted = adj.Delta != 0
+			if adj.EventID != 0 {
+				available, err = availableSeats(tx, adj.EventID)
+			}
+			return err
+		}
+
+		if err := tx.Model(&ShowEvent{}).Where("id = ?", adj.EventID).
+			Update("available_seats", clampedSeats(-adj.Delta)).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&adj).Update("reverted", true).Error; err != nil {
+			return err
+		}
+		reverted = true
+		available, err = availableSeats(tx, adj.EventID)
+		return err
+	})
+	return available, reverted, err
+}
+
+func (r *showEventRepo) SetAvailableSeats(ctx context.Context, eventID uint64, from, to int32) (int32, bool, error) {
+	var (
+		available int32
+		updated   bool
+	)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&ShowEvent{}).
+			Where("id = ? AND available_seats = ? AND total_seats >= ?", eventID, from, to).
+			Update("available_seats", to)
+		if res.Error != nil {
+			return res.Error
+		}
+		updated = res.RowsAffected > 0
+		var err error
+		available, err = availableSeats(tx, eventID)
+		return err
+	})
+	return available, updated, err
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	v1 "eventservice/api/eventservice/v1"
+	moneyv1 "eventservice/api/money/v1"
 	"eventservice/internal/biz"
 	userv1 "userservice/api/userservice/v1"
 
@@ -33,13 +34,15 @@ func (s *ShowEventService) CreateShowEvent(ctx context.Context, req *v1.CreateSh
 
 	return &v1.ShowEventReply{
 		ShowEvent: &v1.ShowEvent{
-			Id:             createdEv.ID,
-			Title:          createdEv.Title,
-			Description:    createdEv.Description,
-			Date:           createdEv.Date.Format(time.RFC3339),
-			TotalSeats:     createdEv.TotalSeats,
-			AvailableSeats: createdEv.AvailableSeats,
-			PricePerSeat:   createdEv.PricePerSeat,
+			Id:              createdEv.ID,
+			Title:           createdEv.Title,
+			Description:     createdEv.Description,
+			Date:            createdEv.Date.Format(time.RFC3339),
+			TotalSeats:      createdEv.TotalSeats,
+			AvailableSeats:  createdEv.AvailableSeats,
+			Price:           &moneyv1.Money{AmountMinor: createdEv.PriceMinor, Currency: createdEv.Currency},
+			MaxSeatsPerUser: createdEv.MaxSeatsPerUser,
+			WaitingRoom:     createdEv.WaitingRoom,
 		},
 	}, nil
 }
@@ -72,13 +75,16 @@ func (s *ShowEventService) ListShowEvents(ctx context.Context, req *v1.ListShowE
 // Update ShowEvent
 func (s *ShowEventService) UpdateShowEvent(ctx context.Context, req *v1.UpdateShowEventRequest) (*v1.ShowEventReply, error) {
 	ev := &biz.ShowEvent{
-		ID:             req.Id,
-		Title:          req.Title,
-		Description:    req.Description,
-		Date:           parseDate(req.Date),
-		TotalSeats:     req.TotalSeats,
-		AvailableSeats: req.AvailableSeats,
-		PricePerSeat:   req.PricePerSeat,
+		ID:              req.Id,
+		Title:           req.Title,
+		Description:     req.Description,
+		Date:            parseDate(req.Date),
+		TotalSeats:      req.TotalSeats,
+		AvailableSeats:  req.AvailableSeats,
+		PriceMinor:      req.GetPrice().GetAmountMinor(),
+		Currency:        req.GetPrice().GetCurrency(),
+		MaxSeatsPerUser: req.MaxSeatsPerUser,
+		WaitingRoom:     req.WaitingRoom,
 	}
 
 	updatedEv, err := s.uc.Update(ctx, ev)
@@ -100,36 +106,52 @@ func (s *ShowEventService) DeleteShowEvent(ctx context.Context, req *v1.DeleteSh
 
 // DecrementSeats - called from BookingService when booking is CONFIRMED
 func (s *ShowEventService) DecrementSeats(ctx context.Context, req *v1.DecrementSeatsRequest) (*v1.DecrementSeatsReply, error) {
-	err := s.uc.DecrementSeats(ctx, req.EventId, req.SeatIds) // SeatIds is now []string
+	available, err := s.uc.DecrementSeats(ctx, req.EventId, req.SeatIds, req.OperationId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to decrement seats: %v", err)
 	}
 
-	ev, err := s.uc.Get(ctx, req.EventId)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch event: %v", err)
-	}
-
 	return &v1.DecrementSeatsReply{
 		Success:        true,
-		AvailableSeats: ev.AvailableSeats,
+		AvailableSeats: available,
 	}, nil
 }
 
 func (s *ShowEventService) IncrementSeats(ctx context.Context, req *v1.IncrementSeatsRequest) (*v1.IncrementSeatsReply, error) {
-	err := s.uc.IncrementSeats(ctx, req.EventId, req.SeatIds) // SeatIds is now []string
+	available, err := s.uc.IncrementSeats(ctx, req.EventId, req.SeatIds, req.OperationId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to increment seats: %v", err)
 	}
 
-	ev, err := s.uc.Get(ctx, req.EventId)
+	return &v1.IncrementSeatsReply{
+		Success:        true,
+		AvailableSeats: available,
+	}, nil
+}
+
+// RevertSeatAdjustment - called from BookingService to compensate a seat change
+func (s *ShowEventService) RevertSeatAdjustment(ctx context.Context, req *v1.RevertSeatAdjustmentRequest) (*v1.RevertSeatAdjustmentReply, error) {
+	available, reverted, err := s.uc.RevertSeatAdjustment(ctx, req.OperationId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revert seat adjustment: %v", err)
+	}
+
+	return &v1.RevertSeatAdjustmentReply{
+		Reverted:       reverted,
+		AvailableSeats: available,
+	}, nil
+}
+
+// SetAvailableSeats - called from BookingService to repair inventory drift
+func (s *ShowEventService) SetAvailableSeats(ctx context.Context, req *v1.SetAvailableSeatsRequest) (*v1.SetAvailableSeatsReply, error) {
+	available, updated, err := s.uc.SetAvailableSeats(ctx, req.EventId, req.ExpectedAvailableSeats, req.AvailableSeats)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to fetch event: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to set available seats: %v", err)
 	}
 
-	return &v1.IncrementSeatsReply{
-		Success:        true,
-		AvailableSeats: ev.AvailableSeats,
+	return &v1.SetAvailableSeatsReply{
+		Updated:        updated,
+		AvailableSeats: available,
 	}, nil
 }
 
@@ -160,13 +182,15 @@ func parseDate(dateStr string) time.Time {
 
 func toProto(ev *biz.ShowEvent) *v1.ShowEvent {
 	return &v1.ShowEvent{
-		Id:             ev.ID,
-		Title:          ev.Title,
-		Description:    ev.Description,
-		Date:           ev.Date.Format(time.RFC3339),
-		TotalSeats:     ev.TotalSeats,
-		AvailableSeats: ev.AvailableSeats,
-		PricePerSeat:   ev.PricePerSeat,
+		Id:              ev.ID,
+		Title:           ev.Title,
+		Description:     ev.Description,
+		Date:            ev.Date.Format(time.RFC3339),
+		TotalSeats:      ev.TotalSeats,
+		AvailableSeats:  ev.AvailableSeats,
+		Price:           &moneyv1.Money{AmountMinor: ev.PriceMinor, Currency: ev.Currency},
+		MaxSeatsPerUser: ev.MaxSeatsPerUser,
+		WaitingRoom:     ev.WaitingRoom,
 	}
 }
 
@@ -0,0 +1,43 @@
+// Package money holds what the services share about amounts kept, like
+// moneyv1.Money, in the minor unit of an ISO 4217 currency.
+package money
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// minorUnitsPerMajor is the number of minor units in one major unit of a
+// row's currency column, per ISO 4217; most currencies have 100.
+const minorUnitsPerMajor = `CASE currency
+	WHEN 'BIF' THEN 1 WHEN 'CLP' THEN 1 WHEN 'DJF' THEN 1 WHEN 'GNF' THEN 1
+	WHEN 'ISK' THEN 1 WHEN 'JPY' THEN 1 WHEN 'KMF' THEN 1 WHEN 'KRW' THEN 1
+	WHEN 'PYG' THEN 1 WHEN 'RWF' THEN 1 WHEN 'UGX' THEN 1 WHEN 'UYI' THEN 1
+	WHEN 'VND' THEN 1 WHEN 'VUV' THEN 1 WHEN 'XAF' THEN 1 WHEN 'XOF' THEN 1
+	WHEN 'XPF' THEN 1
+	WHEN 'BHD' THEN 1000 WHEN 'IQD' THEN 1000 WHEN 'JOD' THEN 1000
+	WHEN 'KWD' THEN 1000 WHEN 'LYD' THEN 1000 WHEN 'OMR' THEN 1000
+	WHEN 'TND' THEN 1000
+	ELSE 100 END`
+
+// MigrateMinorUnits moves the amounts table stored as floats in legacyCol,
+// before money was kept in minor units, over to minorCol, rounding to the
+// nearest minor unit of each row's currency, and drops legacyCol in the
+// same transaction. Once legacyCol is gone it does nothing. It returns how
+// many rows it moved.
+func MigrateMinorUnits(db *gorm.DB, table, legacyCol, minorCol string) (int64, error) {
+	if !db.Migrator().HasColumn(table, legacyCol) {
+		return 0, nil
+	}
+	var moved int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		res := tx.Exec(`UPDATE ? SET ? = ROUND(? * `+minorUnitsPerMajor+`) WHERE ? IS NOT NULL`,
+			clause.Table{Name: table}, clause.Column{Name: minorCol}, clause.Column{Name: legacyCol}, clause.Column{Name: legacyCol})
+		if res.Error != nil {
+			return res.Error
+		}
+		moved = res.RowsAffected
+		return tx.Migrator().DropColumn(table, legacyCol)
+	})
+	return moved, err
+}
@@ -24,7 +24,10 @@ export default function BookingPage() {
     try {
       const res = await fetch("http://localhost:8002/v1/bookings", {
         method: "POST",
-        headers: { "Content-Type": "application/json" },
+        headers: {
+          "Content-Type": "application/json",
+          Authorization: `Bearer ${localStorage.getItem("token")}`,
+        },
         body: JSON.stringify({
           event_id: event.id,
           user_id: user.id,
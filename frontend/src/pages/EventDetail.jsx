@@ -74,7 +74,10 @@ function EventDetailPage() {
         setEvent({
           ...eventData,
           total_seats: eventData.total_seats ?? eventData.totalSeats,
-          price_per_seat: eventData.price_per_seat ?? eventData.pricePerSeat,
+          // price is in minor units (paise)
+          price_per_seat: eventData.price
+            ? Number(eventData.price.amountMinor ?? eventData.price.amount_minor) / 100
+            : undefined,
         });
       } catch (err) {
         console.error("Event Fetch Error:", err);
@@ -85,34 +88,75 @@ function EventDetailPage() {
     fetchEvent();
   }, [eventId]);
 
-  // -------- Fetch Seats --------
-  const fetchSeats = async () => {
-    try {
-      const [bookedRes, lockedRes] = await Promise.all([
-        fetch(`http://localhost:8002/events/${eventId}/booked-seats`),
-        fetch(`http://localhost:8002/events/${eventId}/locked-seats`),
-      ]);
-
-      if (!bookedRes.ok || !lockedRes.ok) throw new Error("Failed to fetch seat data");
-
-      const bookedData = await bookedRes.json();
-      const lockedData = await lockedRes.json();
-
-      setBookedSeats(cleanSeatIds(bookedData.seatIds || bookedData.seat_ids));
-      setLockedSeats(cleanSeatIds(lockedData.seatIds || lockedData.seat_ids));
-    } catch (err) {
-      console.error("Seat Fetch Error:", err);
-      setBookedSeats([]);
-      setLockedSeats([]);
-    }
-  };
+  // -------- Live Seats (SSE) --------
+  // The stream opens with a snapshot of booked and held seats, then sends
+  // every hold, release, booking and cancellation. Holds carry their expiry
+  // and lapse here without a message.
+  const [heldUntil, setHeldUntil] = useState({});
 
   useEffect(() => {
-    fetchSeats();
-    const interval = setInterval(fetchSeats, 5000);
-    return () => clearInterval(interval);
+    if (isNaN(eventId)) return;
+    const source = new EventSource(`http://localhost:8002/v1/events/${eventId}/seats/stream`);
+    let resync = true;
+
+    source.addEventListener("seats", (e) => {
+      const update = JSON.parse(e.data);
+      const seats = cleanSeatIds(update.seat_ids);
+      if (update.snapshot && resync) {
+        // First message of a (re)connected stream replaces what we had
+        resync = false;
+        setBookedSeats([]);
+        setHeldUntil({});
+      }
+      if (!update.snapshot) resync = true;
+
+      switch (update.kind) {
+        case "SEAT_BOOKED":
+          setBookedSeats((prev) => cleanSeatIds([...prev, ...seats]));
+          break;
+        case "SEAT_CANCELLED":
+          setBookedSeats((prev) => prev.filter((s) => !seats.includes(s)));
+          break;
+        case "SEAT_HELD": {
+          const until = Date.parse(update.expires_at) || Date.now();
+          setHeldUntil((prev) => {
+            const next = { ...prev };
+            seats.forEach((s) => (next[s] = until));
+            return next;
+          });
+          break;
+        }
+        case "SEAT_RELEASED":
+          setHeldUntil((prev) => {
+            const next = { ...prev };
+            seats.forEach((s) => delete next[s]);
+            return next;
+          });
+          break;
+        default:
+          break;
+      }
+    });
+    source.onerror = (err) => {
+      console.error("Seat Stream Error:", err);
+      resync = true; // EventSource reconnects by itself and gets a new snapshot
+    };
+
+    return () => source.close();
   }, [eventId]);
 
+  // Drop holds as they expire
+  useEffect(() => {
+    const sweep = () => {
+      const now = Date.now();
+      const held = Object.keys(heldUntil).filter((s) => heldUntil[s] > now);
+      setLockedSeats(held);
+    };
+    sweep();
+    const interval = setInterval(sweep, 1000);
+    return () => clearInterval(interval);
+  }, [heldUntil]);
+
   // -------- Seat Selection Logic (Fixed) --------
   const toggleSeat = (seatId, tier) => {
     if (bookedSeats.includes(seatId) || lockedSeats.includes(seatId)) return;
@@ -30,7 +30,8 @@ export default function PaymentPage() {
             ...data.booking,
             // Ensure totalCost exists
             totalCost:
-              data.booking.totalCost ||
+              (data.booking.totalCost &&
+                Number(data.booking.totalCost.amountMinor) / 100) ||
               data.booking.seats.reduce((sum, s) => sum + s.price, 0),
           });
         })
@@ -60,7 +61,10 @@ export default function PaymentPage() {
       if (res.ok && data.status === "PAID") {
         await fetch(`http://localhost:8002/v1/bookings/${booking.id}/confirm`, {
           method: "PUT",
-          headers: { "Content-Type": "application/json" },
+          headers: {
+            "Content-Type": "application/json",
+            Authorization: `Bearer ${localStorage.getItem("token")}`,
+          },
         });
 
         setPaymentSuccess(true);
@@ -119,6 +119,208 @@ func (x *SendBookingNotificationReply) GetMessage() string {
 	return ""
 }
 
+// Request for telling a waitlisted user that seats are offered to them
+type SendWaitlistOfferNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       uint64                 `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // waitlist entry holding the offer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWaitlistOfferNotificationRequest) Reset() {
+	*x = SendWaitlistOfferNotificationRequest{}
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWaitlistOfferNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWaitlistOfferNotificationRequest) ProtoMessage() {}
+
+func (x *SendWaitlistOfferNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWaitlistOfferNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendWaitlistOfferNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notificationservice_v1_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendWaitlistOfferNotificationRequest) GetEntryId() uint64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type SendWaitlistOfferNotificationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendWaitlistOfferNotificationReply) Reset() {
+	*x = SendWaitlistOfferNotificationReply{}
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendWaitlistOfferNotificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendWaitlistOfferNotificationReply) ProtoMessage() {}
+
+func (x *SendWaitlistOfferNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendWaitlistOfferNotificationReply.ProtoReflect.Descriptor instead.
+func (*SendWaitlistOfferNotificationReply) Descriptor() ([]byte, []int) {
+	return file_notificationservice_v1_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendWaitlistOfferNotificationReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendWaitlistOfferNotificationReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request for telling the parties of a booking transfer about it
+type SendTransferNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    uint64                 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Accepted      bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"` // false: the recipient is asked to accept; true: both parties hear it went through
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTransferNotificationRequest) Reset() {
+	*x = SendTransferNotificationRequest{}
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTransferNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTransferNotificationRequest) ProtoMessage() {}
+
+func (x *SendTransferNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTransferNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendTransferNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notificationservice_v1_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SendTransferNotificationRequest) GetTransferId() uint64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *SendTransferNotificationRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type SendTransferNotificationReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTransferNotificationReply) Reset() {
+	*x = SendTransferNotificationReply{}
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTransferNotificationReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTransferNotificationReply) ProtoMessage() {}
+
+func (x *SendTransferNotificationReply) ProtoReflect() protoreflect.Message {
+	mi := &file_notificationservice_v1_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTransferNotificationReply.ProtoReflect.Descriptor instead.
+func (*SendTransferNotificationReply) Descriptor() ([]byte, []int) {
+	return file_notificationservice_v1_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendTransferNotificationReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendTransferNotificationReply) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_notificationservice_v1_notification_proto protoreflect.FileDescriptor
 
 const file_notificationservice_v1_notification_proto_rawDesc = "" +
@@ -129,9 +331,23 @@ const file_notificationservice_v1_notification_proto_rawDesc = "" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\"R\n" +
 	"\x1cSendBookingNotificationReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x9f\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"A\n" +
+	"$SendWaitlistOfferNotificationRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x04R\aentryId\"X\n" +
+	"\"SendWaitlistOfferNotificationReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
+	"\x1fSendTransferNotificationRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x04R\n" +
+	"transferId\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\"S\n" +
+	"\x1dSendTransferNotificationReply\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xc8\x03\n" +
 	"\x13NotificationService\x12\x87\x01\n" +
-	"\x17SendBookingNotification\x126.notificationservice.v1.SendBookingNotificationRequest\x1a4.notificationservice.v1.SendBookingNotificationReplyB3Z1notificationservice/api/notificationservice/v1;v1b\x06proto3"
+	"\x17SendBookingNotification\x126.notificationservice.v1.SendBookingNotificationRequest\x1a4.notificationservice.v1.SendBookingNotificationReply\x12\x99\x01\n" +
+	"\x1dSendWaitlistOfferNotification\x12<.notificationservice.v1.SendWaitlistOfferNotificationRequest\x1a:.notificationservice.v1.SendWaitlistOfferNotificationReply\x12\x8a\x01\n" +
+	"\x18SendTransferNotification\x127.notificationservice.v1.SendTransferNotificationRequest\x1a5.notificationservice.v1.SendTransferNotificationReplyB3Z1notificationservice/api/notificationservice/v1;v1b\x06proto3"
 
 var (
 	file_notificationservice_v1_notification_proto_rawDescOnce sync.Once
@@ -145,16 +361,24 @@ func file_notificationservice_v1_notification_proto_rawDescGZIP() []byte {
 	return file_notificationservice_v1_notification_proto_rawDescData
 }
 
-var file_notificationservice_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_notificationservice_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_notificationservice_v1_notification_proto_goTypes = []any{
-	(*SendBookingNotificationRequest)(nil), // 0: notificationservice.v1.SendBookingNotificationRequest
-	(*SendBookingNotificationReply)(nil),   // 1: notificationservice.v1.SendBookingNotificationReply
+	(*SendBookingNotificationRequest)(nil),       // 0: notificationservice.v1.SendBookingNotificationRequest
+	(*SendBookingNotificationReply)(nil),         // 1: notificationservice.v1.SendBookingNotificationReply
+	(*SendWaitlistOfferNotificationRequest)(nil), // 2: notificationservice.v1.SendWaitlistOfferNotificationRequest
+	(*SendWaitlistOfferNotificationReply)(nil),   // 3: notificationservice.v1.SendWaitlistOfferNotificationReply
+	(*SendTransferNotificationRequest)(nil),      // 4: notificationservice.v1.SendTransferNotificationRequest
+	(*SendTransferNotificationReply)(nil),        // 5: notificationservice.v1.SendTransferNotificationReply
 }
 var file_notificationservice_v1_notification_proto_depIdxs = []int32{
 	0, // 0: notificationservice.v1.NotificationService.SendBookingNotification:input_type -> notificationservice.v1.SendBookingNotificationRequest
-	1, // 1: notificationservice.v1.NotificationService.SendBookingNotification:output_type -> notificationservice.v1.SendBookingNotificationReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: notificationservice.v1.NotificationService.SendWaitlistOfferNotification:input_type -> notificationservice.v1.SendWaitlistOfferNotificationRequest
+	4, // 2: notificationservice.v1.NotificationService.SendTransferNotification:input_type -> notificationservice.v1.SendTransferNotificationRequest
+	1, // 3: notificationservice.v1.NotificationService.SendBookingNotification:output_type -> notificationservice.v1.SendBookingNotificationReply
+	3, // 4: notificationservice.v1.NotificationService.SendWaitlistOfferNotification:output_type -> notificationservice.v1.SendWaitlistOfferNotificationReply
+	5, // 5: notificationservice.v1.NotificationService.SendTransferNotification:output_type -> notificationservice.v1.SendTransferNotificationReply
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -171,7 +395,7 @@ func file_notificationservice_v1_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notificationservice_v1_notification_proto_rawDesc), len(file_notificationservice_v1_notification_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NotificationService_SendBookingNotification_FullMethodName = "/notificationservice.v1.NotificationService/SendBookingNotification"
+	NotificationService_SendBookingNotification_FullMethodName       = "/notificationservice.v1.NotificationService/SendBookingNotification"
+	NotificationService_SendWaitlistOfferNotification_FullMethodName = "/notificationservice.v1.NotificationService/SendWaitlistOfferNotification"
+	NotificationService_SendTransferNotification_FullMethodName      = "/notificationservice.v1.NotificationService/SendTransferNotification"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -29,6 +31,8 @@ const (
 // Notification service
 type NotificationServiceClient interface {
 	SendBookingNotification(ctx context.Context, in *SendBookingNotificationRequest, opts ...grpc.CallOption) (*SendBookingNotificationReply, error)
+	SendWaitlistOfferNotification(ctx context.Context, in *SendWaitlistOfferNotificationRequest, opts ...grpc.CallOption) (*SendWaitlistOfferNotificationReply, error)
+	SendTransferNotification(ctx context.Context, in *SendTransferNotificationRequest, opts ...grpc.CallOption) (*SendTransferNotificationReply, error)
 }
 
 type notificationServiceClient struct {
@@ -49,6 +53,26 @@ func (c *notificationServiceClient) SendBookingNotification(ctx context.Context,
 	return out, nil
 }
 
+func (c *notificationServiceClient) SendWaitlistOfferNotification(ctx context.Context, in *SendWaitlistOfferNotificationRequest, opts ...grpc.CallOption) (*SendWaitlistOfferNotificationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendWaitlistOfferNotificationReply)
+	err := c.cc.Invoke(ctx, NotificationService_SendWaitlistOfferNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SendTransferNotification(ctx context.Context, in *SendTransferNotificationRequest, opts ...grpc.CallOption) (*SendTransferNotificationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendTransferNotificationReply)
+	err := c.cc.Invoke(ctx, NotificationService_SendTransferNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility.
@@ -56,6 +80,8 @@ func (c *notificationServiceClient) SendBookingNotification(ctx context.Context,
 // Notification service
 type NotificationServiceServer interface {
 	SendBookingNotification(context.Context, *SendBookingNotificationRequest) (*SendBookingNotificationReply, error)
+	SendWaitlistOfferNotification(context.Context, *SendWaitlistOfferNotificationRequest) (*SendWaitlistOfferNotificationReply, error)
+	SendTransferNotification(context.Context, *SendTransferNotificationRequest) (*SendTransferNotificationReply, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -69,6 +95,12 @@ type UnimplementedNotificationServiceServer struct{}
 func (UnimplementedNotificationServiceServer) SendBookingNotification(context.Context, *SendBookingNotificationRequest) (*SendBookingNotificationReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendBookingNotification not implemented")
 }
+func (UnimplementedNotificationServiceServer) SendWaitlistOfferNotification(context.Context, *SendWaitlistOfferNotificationRequest) (*SendWaitlistOfferNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendWaitlistOfferNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) SendTransferNotification(context.Context, *SendTransferNotificationRequest) (*SendTransferNotificationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransferNotification not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
 
@@ -108,6 +140,42 @@ func _NotificationService_SendBookingNotification_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_SendWaitlistOfferNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendWaitlistOfferNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendWaitlistOfferNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendWaitlistOfferNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendWaitlistOfferNotification(ctx, req.(*SendWaitlistOfferNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SendTransferNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransferNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SendTransferNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SendTransferNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SendTransferNotification(ctx, req.(*SendTransferNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,6 +187,14 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendBookingNotification",
 			Handler:    _NotificationService_SendBookingNotification_Handler,
 		},
+		{
+			MethodName: "SendWaitlistOfferNotification",
+			Handler:    _NotificationService_SendWaitlistOfferNotification_Handler,
+		},
+		{
+			MethodName: "SendTransferNotification",
+			Handler:    _NotificationService_SendTransferNotification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notificationservice/v1/notification.proto",
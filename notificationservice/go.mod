@@ -6,6 +6,7 @@ toolchain go1.24.5
 
 require (
 	bookingservice v0.0.0
+	eventservice v0.0.0
 	github.com/go-kratos/kratos/v2 v2.9.1
 	github.com/google/wire v0.6.0
 	go.uber.org/automaxprocs v1.5.1
@@ -18,6 +19,8 @@ require (
 
 replace bookingservice => ../bookingservice
 
+replace eventservice => ../eventservice
+
 replace userservice => ../userservice
 
 require (
@@ -7,6 +7,7 @@ import (
     notifv1 "notificationservice/api/notificationservice/v1"
     "notificationservice/internal/biz"
     bookingv1 "bookingservice/api/bookingservice/v1"
+    moneyv1 "eventservice/api/money/v1"
 
     "github.com/go-kratos/kratos/v2/log"
 )
@@ -46,7 +47,7 @@ func (s *NotificationService) SendBookingNotification(ctx context.Context, req *
     booking := bookingResp.Booking
 
     // 2️⃣ Only confirmed bookings
-    if booking.Status != "CONFIRMED" {
+    if booking.Status != bookingv1.BookingStatus_CONFIRMED {
         return &notifv1.SendBookingNotificationReply{
             Success: false,
             Message: "Booking not confirmed",
@@ -67,12 +68,12 @@ func (s *NotificationService) SendBookingNotification(ctx context.Context, req *
 
     // 4️⃣ Prepare notification
     body := fmt.Sprintf(
-        "Hello %s,\n\nYour booking has been confirmed!\n\nBooking ID: %d\nEvent ID: %d\nSeats: %v\nTotal Cost: %.2f\nBooked At: %s\nStatus: %s",
+        "Hello %s,\n\nYour booking has been confirmed!\n\nBooking ID: %d\nEvent ID: %d\nSeats: %v\nTotal Cost: %s\nBooked At: %s\nStatus: %s",
         userResp.Name,
         booking.Id,
         booking.EventId,
         booking.SeatIds,
-        booking.TotalCost,
+        formatMoney(booking.TotalCost),
         booking.CreatedAt,
         booking.Status,
     )
@@ -82,7 +83,7 @@ func (s *NotificationService) SendBookingNotification(ctx context.Context, req *
         Email:     userResp.Email,
         Subject:   "Booking Confirmed",
         Body:      body,
-        Status:    booking.Status,
+        Status:    booking.Status.String(),
     }
 
     // 5️⃣ Send
@@ -101,3 +102,165 @@ func (s *NotificationService) SendBookingNotification(ctx context.Context, req *
         Message: "Notification sent successfully",
     }, nil
 }
+
+func (s *NotificationService) SendWaitlistOfferNotification(ctx context.Context, req *notifv1.SendWaitlistOfferNotificationRequest) (*notifv1.SendWaitlistOfferNotificationReply, error) {
+    // 1️⃣ Fetch waitlist entry
+    entryResp, err := s.bookingClient.GetWaitlistEntry(ctx, &bookingv1.GetWaitlistEntryRequest{Id: req.EntryId})
+    if err != nil || entryResp.Entry == nil {
+        s.log.Errorf("WaitlistEntryID=%d not found: %v", req.EntryId, err)
+        return &notifv1.SendWaitlistOfferNotificationReply{
+            Success: false,
+            Message: "Waitlist entry not found",
+        }, err
+    }
+    entry := entryResp.Entry
+
+    // 2️⃣ Only open offers
+    if entry.State != bookingv1.WaitlistState_WAITLIST_OFFERED {
+        return &notifv1.SendWaitlistOfferNotificationReply{
+            Success: false,
+            Message: "Waitlist offer no longer open",
+        }, nil
+    }
+
+    // 3️⃣ Fetch user
+    userResp, err := s.userClient.GetUser(ctx, &userv1.GetUserRequest{Id: entry.UserId})
+    if err != nil {
+        s.log.Errorf("Failed to fetch user for WaitlistEntryID=%d, UserID=%d: %v", entry.Id, entry.UserId, err)
+        return &notifv1.SendWaitlistOfferNotificationReply{
+            Success: false,
+            Message: "Failed to fetch user info",
+        }, err
+    }
+
+    // 4️⃣ Prepare notification
+    body := fmt.Sprintf(
+        "Hello %s,\n\nSeats are available for an event you are waiting for!\n\nWaitlist Entry ID: %d\nEvent ID: %d\nSeats: %d\nOffer Expires At: %s\n\nAccept the offer and choose your seats before it expires.",
+        userResp.Name,
+        entry.Id,
+        entry.EventId,
+        entry.SeatCount,
+        entry.OfferExpiresAt,
+    )
+
+    notif := &biz.Notification{
+        Email:   userResp.Email,
+        Subject: "Seats Available",
+        Body:    body,
+        Status:  entry.State.String(),
+    }
+
+    // 5️⃣ Send
+    if err := s.uc.Send(ctx, notif); err != nil {
+        s.log.Errorf("Failed to send waitlist offer for WaitlistEntryID=%d to %s: %v", entry.Id, userResp.Email, err)
+        return &notifv1.SendWaitlistOfferNotificationReply{
+            Success: false,
+            Message: "Failed to send notification",
+        }, err
+    }
+
+    s.log.Infof("Waitlist offer sent for WaitlistEntryID=%d to %s", entry.Id, userResp.Email)
+
+    return &notifv1.SendWaitlistOfferNotificationReply{
+        Success: true,
+        Message: "Notification sent successfully",
+    }, nil
+}
+
+func (s *NotificationService) SendTransferNotification(ctx context.Context, req *notifv1.SendTransferNotificationRequest) (*notifv1.SendTransferNotificationReply, error) {
+    // 1️⃣ Fetch transfer
+    transferResp, err := s.bookingClient.GetTransfer(ctx, &bookingv1.GetTransferRequest{Id: req.TransferId})
+    if err != nil || transferResp.Transfer == nil {
+        s.log.Errorf("TransferID=%d not found: %v", req.TransferId, err)
+        return &notifv1.SendTransferNotificationReply{
+            Success: false,
+            Message: "Transfer not found",
+        }, err
+    }
+    transfer := transferResp.Transfer
+
+    // 2️⃣ Fetch both parties
+    sender, err := s.userClient.GetUser(ctx, &userv1.GetUserRequest{Id: transfer.FromUserId})
+    if err != nil {
+        s.log.Errorf("Failed to fetch sender for TransferID=%d, UserID=%d: %v", transfer.Id, transfer.FromUserId, err)
+        return &notifv1.SendTransferNotificationReply{
+            Success: false,
+            Message: "Failed to fetch user info",
+        }, err
+    }
+    recipient, err := s.userClient.GetUser(ctx, &userv1.GetUserRequest{Id: transfer.ToUserId})
+    if err != nil {
+        s.log.Errorf("Failed to fetch recipient for TransferID=%d, UserID=%d: %v", transfer.Id, transfer.ToUserId, err)
+        return &notifv1.SendTransferNotificationReply{
+            Success: false,
+            Message: "Failed to fetch user info",
+        }, err
+    }
+
+    // 3️⃣ Prepare notifications
+    var notifs []*biz.Notification
+    if !req.Accepted {
+        notifs = append(notifs, &biz.Notification{
+            BookingID: transfer.BookingId,
+            Email:     recipient.Email,
+            Subject:   "Ticket Transfer Offered",
+            Body: fmt.Sprintf(
+                "Hello %s,\n\n%s (%s) wants to transfer a booking to you.\n\nTransfer ID: %d\nBooking ID: %d\nAccept By: %s",
+                recipient.Name, sender.Name, sender.Email, transfer.Id, transfer.BookingId, transfer.ExpiresAt,
+            ),
+            Status: transfer.State.String(),
+        })
+    } else {
+        notifs = append(notifs,
+            &biz.Notification{
+                BookingID: transfer.BookingId,
+                Email:     sender.Email,
+                Subject:   "Ticket Transferred",
+                Body: fmt.Sprintf(
+                    "Hello %s,\n\nYour booking has been transferred to %s. Your tickets for it are no longer valid.\n\nTransfer ID: %d\nBooking ID: %d\nTransferred At: %s",
+                    sender.Name, recipient.Email, transfer.Id, transfer.BookingId, transfer.ResolvedAt,
+                ),
+                Status: transfer.State.String(),
+            },
+            &biz.Notification{
+                BookingID: transfer.BookingId,
+                Email:     recipient.Email,
+                Subject:   "Ticket Received",
+                Body: fmt.Sprintf(
+                    "Hello %s,\n\nThe booking from %s is now yours.\n\nTransfer ID: %d\nBooking ID: %d\nTransferred At: %s",
+                    recipient.Name, sender.Name, transfer.Id, transfer.BookingId, transfer.ResolvedAt,
+                ),
+                Status: transfer.State.String(),
+            },
+        )
+    }
+
+    // 4️⃣ Send
+    for _, notif := range notifs {
+        if err := s.uc.Send(ctx, notif); err != nil {
+            s.log.Errorf("Failed to send transfer notification for TransferID=%d to %s: %v", transfer.Id, notif.Email, err)
+            return &notifv1.SendTransferNotificationReply{
+                Success: false,
+                Message: "Failed to send notification",
+            }, err
+        }
+    }
+
+    s.log.Infof("Transfer notification sent for TransferID=%d (accepted=%t)", transfer.Id, req.Accepted)
+
+    return &notifv1.SendTransferNotificationReply{
+        Success: true,
+        Message: "Notification sent successfully",
+    }, nil
+}
+
+// formatMoney renders an amount in minor units with two decimals, e.g.
+// "INR 499.50".
+func formatMoney(m *moneyv1.Money) string {
+    minor := m.GetAmountMinor()
+    sign := ""
+    if minor < 0 {
+        sign, minor = "-", -minor
+    }
+    return fmt.Sprintf("%s %s%d.%02d", m.GetCurrency(), sign, minor/100, minor%100)
+}
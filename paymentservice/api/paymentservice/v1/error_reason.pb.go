@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v6.32.0
+// source: paymentservice/v1/error_reason.proto
+
+package v1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorReason int32
+
+const (
+	ErrorReason_PAYMENT_UNSPECIFIED ErrorReason = 0
+	// An idempotency key was reused with a different request body.
+	ErrorReason_IDEMPOTENCY_KEY_REUSED ErrorReason = 1
+	// The first request with this idempotency key is still being processed.
+	ErrorReason_IDEMPOTENCY_REQUEST_IN_PROGRESS ErrorReason = 2
+	// The booking has no paid payment to refund.
+	ErrorReason_PAYMENT_NOT_FOUND ErrorReason = 3
+	// The refund is more than what is left of the booking's payments.
+	ErrorReason_REFUND_EXCEEDS_PAYMENT ErrorReason = 4
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0: "PAYMENT_UNSPECIFIED",
+		1: "IDEMPOTENCY_KEY_REUSED",
+		2: "IDEMPOTENCY_REQUEST_IN_PROGRESS",
+		3: "PAYMENT_NOT_FOUND",
+		4: "REFUND_EXCEEDS_PAYMENT",
+	}
+	ErrorReason_value = map[string]int32{
+		"PAYMENT_UNSPECIFIED":             0,
+		"IDEMPOTENCY_KEY_REUSED":          1,
+		"IDEMPOTENCY_REQUEST_IN_PROGRESS": 2,
+		"PAYMENT_NOT_FOUND":               3,
+		"REFUND_EXCEEDS_PAYMENT":          4,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_paymentservice_v1_error_reason_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_paymentservice_v1_error_reason_proto_enumTypes[0]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_paymentservice_v1_error_reason_proto_rawDescGZIP(), []int{0}
+}
+
+var File_paymentservice_v1_error_reason_proto protoreflect.FileDescriptor
+
+const file_paymentservice_v1_error_reason_proto_rawDesc = "" +
+	"\n" +
+	"$paymentservice/v1/error_reason.proto\x12\x11paymentservice.v1\x1a\x13errors/errors.proto*\xb2\x01\n" +
+	"\vErrorReason\x12\x17\n" +
+	"\x13PAYMENT_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x16IDEMPOTENCY_KEY_REUSED\x10\x01\x1a\x04\xa8E\x99\x03\x12)\n" +
+	"\x1fIDEMPOTENCY_REQUEST_IN_PROGRESS\x10\x02\x1a\x04\xa8E\x99\x03\x12\x1b\n" +
+	"\x11PAYMENT_NOT_FOUND\x10\x03\x1a\x04\xa8E\x94\x03\x12 \n" +
+	"\x16REFUND_EXCEEDS_PAYMENT\x10\x04\x1a\x04\xa8E\x99\x03BT\n" +
+	"\x11paymentservice.v1P\x01Z'paymentservice/api/paymentservice/v1;v1\xa2\x02\x13APIPaymentserviceV1b\x06proto3"
+
+var (
+	file_paymentservice_v1_error_reason_proto_rawDescOnce sync.Once
+	file_paymentservice_v1_error_reason_proto_rawDescData []byte
+)
+
+func file_paymentservice_v1_error_reason_proto_rawDescGZIP() []byte {
+	file_paymentservice_v1_error_reason_proto_rawDescOnce.Do(func() {
+		file_paymentservice_v1_error_reason_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_paymentservice_v1_error_reason_proto_rawDesc), len(file_paymentservice_v1_error_reason_proto_rawDesc)))
+	})
+	return file_paymentservice_v1_error_reason_proto_rawDescData
+}
+
+var file_paymentservice_v1_error_reason_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_paymentservice_v1_error_reason_proto_goTypes = []any{
+	(ErrorReason)(0), // 0: paymentservice.v1.ErrorReason
+}
+var file_paymentservice_v1_error_reason_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_paymentservice_v1_error_reason_proto_init() }
+func file_paymentservice_v1_error_reason_proto_init() {
+	if File_paymentservice_v1_error_reason_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paymentservice_v1_error_reason_proto_rawDesc), len(file_paymentservice_v1_error_reason_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_paymentservice_v1_error_reason_proto_goTypes,
+		DependencyIndexes: file_paymentservice_v1_error_reason_proto_depIdxs,
+		EnumInfos:         file_paymentservice_v1_error_reason_proto_enumTypes,
+	}.Build()
+	File_paymentservice_v1_error_reason_proto = out.File
+	file_paymentservice_v1_error_reason_proto_goTypes = nil
+	file_paymentservice_v1_error_reason_proto_depIdxs = nil
+}
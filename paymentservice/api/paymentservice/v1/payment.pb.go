@@ -7,6 +7,7 @@
 package v1
 
 import (
+	v1 "eventservice/api/money/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -22,13 +23,14 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Request: only booking_id and payment_method
+// Request: booking_id, payment_method and an optional idempotency key
 type CreatePaymentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PaymentMethod  string                 `protobuf:"bytes,2,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // client-chosen key; a retry with the same key returns the first payment (the Idempotency-Key header works too)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreatePaymentRequest) Reset() {
@@ -75,15 +77,22 @@ func (x *CreatePaymentRequest) GetPaymentMethod() string {
 	return ""
 }
 
+func (x *CreatePaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 // Response: all details returned by service
 type CreatePaymentReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PaymentId     uint64                 `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
 	BookingId     uint64                 `protobuf:"varint,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	Amount        float32                `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
 	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // PENDING / PAID / FAILED
 	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // formatted timestamp
+	Amount        *v1.Money              `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,55 +141,320 @@ func (x *CreatePaymentReply) GetBookingId() uint64 {
 	return 0
 }
 
-func (x *CreatePaymentReply) GetAmount() float32 {
+func (x *CreatePaymentReply) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreatePaymentReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreatePaymentReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CreatePaymentReply) GetAmount() *v1.Money {
 	if x != nil {
 		return x.Amount
 	}
+	return nil
+}
+
+// Request: booking_id, the extra amount and an optional idempotency key
+type ChargeBookingRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PaymentMethod  string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Reason         string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // a retry with the same key returns the first charge (the Idempotency-Key header works too)
+	Amount         *v1.Money              `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`                                       // in the booking's currency
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChargeBookingRequest) Reset() {
+	*x = ChargeBookingRequest{}
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeBookingRequest) ProtoMessage() {}
+
+func (x *ChargeBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeBookingRequest.ProtoReflect.Descriptor instead.
+func (*ChargeBookingRequest) Descriptor() ([]byte, []int) {
+	return file_paymentservice_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChargeBookingRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
 	return 0
 }
 
-func (x *CreatePaymentReply) GetMethod() string {
+func (x *ChargeBookingRequest) GetPaymentMethod() string {
 	if x != nil {
-		return x.Method
+		return x.PaymentMethod
 	}
 	return ""
 }
 
-func (x *CreatePaymentReply) GetStatus() string {
+func (x *ChargeBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ChargeBookingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ChargeBookingRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Request: booking_id, the amount to give back and an optional idempotency key
+type RefundPaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BookingId      uint64                 `protobuf:"varint,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // a retry with the same key returns the first refund (the Idempotency-Key header works too)
+	Amount         *v1.Money              `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`                                       // in the booking's currency
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_paymentservice_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RefundPaymentRequest) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *RefundPaymentRequest) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+type RefundPaymentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      uint64                 `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	PaymentId     uint64                 `protobuf:"varint,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	BookingId     uint64                 `protobuf:"varint,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // REFUNDED
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // formatted timestamp
+	Amount        *v1.Money              `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundedTotal *v1.Money              `protobuf:"bytes,9,opt,name=refunded_total,json=refundedTotal,proto3" json:"refunded_total,omitempty"` // all refunds of the booking so far, this one included
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentReply) Reset() {
+	*x = RefundPaymentReply{}
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentReply) ProtoMessage() {}
+
+func (x *RefundPaymentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_paymentservice_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentReply.ProtoReflect.Descriptor instead.
+func (*RefundPaymentReply) Descriptor() ([]byte, []int) {
+	return file_paymentservice_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefundPaymentReply) GetRefundId() uint64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *RefundPaymentReply) GetPaymentId() uint64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundPaymentReply) GetBookingId() uint64 {
+	if x != nil {
+		return x.BookingId
+	}
+	return 0
+}
+
+func (x *RefundPaymentReply) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *CreatePaymentReply) GetCreatedAt() string {
+func (x *RefundPaymentReply) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
+func (x *RefundPaymentReply) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundPaymentReply) GetRefundedTotal() *v1.Money {
+	if x != nil {
+		return x.RefundedTotal
+	}
+	return nil
+}
+
 var File_paymentservice_v1_payment_proto protoreflect.FileDescriptor
 
 const file_paymentservice_v1_payment_proto_rawDesc = "" +
 	"\n" +
-	"\x1fpaymentservice/v1/payment.proto\x12\x11paymentservice.v1\x1a\x1cgoogle/api/annotations.proto\"\\\n" +
+	"\x1fpaymentservice/v1/payment.proto\x12\x11paymentservice.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x14money/v1/money.proto\"\x85\x01\n" +
 	"\x14CreatePaymentRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12%\n" +
-	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\"\xb9\x01\n" +
+	"\x0epayment_method\x18\x02 \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\xd0\x01\n" +
 	"\x12CreatePaymentReply\x12\x1d\n" +
 	"\n" +
 	"payment_id\x18\x01 \x01(\x04R\tpaymentId\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x02 \x01(\x04R\tbookingId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x02R\x06amount\x12\x16\n" +
 	"\x06method\x18\x04 \x01(\tR\x06method\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt2\x8a\x01\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12'\n" +
+	"\x06amount\x18\a \x01(\v2\x0f.money.v1.MoneyR\x06amountJ\x04\b\x03\x10\x04\"\xcc\x01\n" +
+	"\x14ChargeBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12'\n" +
+	"\x06amount\x18\x06 \x01(\v2\x0f.money.v1.MoneyR\x06amountJ\x04\b\x02\x10\x03\"\xa5\x01\n" +
+	"\x14RefundPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\x04R\tbookingId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12'\n" +
+	"\x06amount\x18\x05 \x01(\v2\x0f.money.v1.MoneyR\x06amountJ\x04\b\x02\x10\x03\"\x93\x02\n" +
+	"\x12RefundPaymentReply\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\x04R\brefundId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\x04R\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x03 \x01(\x04R\tbookingId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12'\n" +
+	"\x06amount\x18\b \x01(\v2\x0f.money.v1.MoneyR\x06amount\x126\n" +
+	"\x0erefunded_total\x18\t \x01(\v2\x0f.money.v1.MoneyR\rrefundedTotalJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x062\xcc\x02\n" +
 	"\x0ePaymentService\x12x\n" +
-	"\rCreatePayment\x12'.paymentservice.v1.CreatePaymentRequest\x1a%.paymentservice.v1.CreatePaymentReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/paymentsBd\n" +
+	"\rCreatePayment\x12'.paymentservice.v1.CreatePaymentRequest\x1a%.paymentservice.v1.CreatePaymentReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/payments\x12_\n" +
+	"\rChargeBooking\x12'.paymentservice.v1.ChargeBookingRequest\x1a%.paymentservice.v1.CreatePaymentReply\x12_\n" +
+	"\rRefundPayment\x12'.paymentservice.v1.RefundPaymentRequest\x1a%.paymentservice.v1.RefundPaymentReplyBd\n" +
 	" dev.kratos.api.paymentservice.v1B\x15PaymentserviceProtoV1P\x01Z'paymentservice/api/paymentservice/v1;v1b\x06proto3"
 
 var (
@@ -195,19 +469,32 @@ func file_paymentservice_v1_payment_proto_rawDescGZIP() []byte {
 	return file_paymentservice_v1_payment_proto_rawDescData
 }
 
-var file_paymentservice_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_paymentservice_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_paymentservice_v1_payment_proto_goTypes = []any{
 	(*CreatePaymentRequest)(nil), // 0: paymentservice.v1.CreatePaymentRequest
 	(*CreatePaymentReply)(nil),   // 1: paymentservice.v1.CreatePaymentReply
+	(*ChargeBookingRequest)(nil), // 2: paymentservice.v1.ChargeBookingRequest
+	(*RefundPaymentRequest)(nil), // 3: paymentservice.v1.RefundPaymentRequest
+	(*RefundPaymentReply)(nil),   // 4: paymentservice.v1.RefundPaymentReply
+	(*v1.Money)(nil),             // 5: money.v1.Money
 }
 var file_paymentservice_v1_payment_proto_depIdxs = []int32{
-	0, // 0: paymentservice.v1.PaymentService.CreatePayment:input_type -> paymentservice.v1.CreatePaymentRequest
-	1, // 1: paymentservice.v1.PaymentService.CreatePayment:output_type -> paymentservice.v1.CreatePaymentReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5, // 0: paymentservice.v1.CreatePaymentReply.amount:type_name -> money.v1.Money
+	5, // 1: paymentservice.v1.ChargeBookingRequest.amount:type_name -> money.v1.Money
+	5, // 2: paymentservice.v1.RefundPaymentRequest.amount:type_name -> money.v1.Money
+	5, // 3: paymentservice.v1.RefundPaymentReply.amount:type_name -> money.v1.Money
+	5, // 4: paymentservice.v1.RefundPaymentReply.refunded_total:type_name -> money.v1.Money
+	0, // 5: paymentservice.v1.PaymentService.CreatePayment:input_type -> paymentservice.v1.CreatePaymentRequest
+	2, // 6: paymentservice.v1.PaymentService.ChargeBooking:input_type -> paymentservice.v1.ChargeBookingRequest
+	3, // 7: paymentservice.v1.PaymentService.RefundPayment:input_type -> paymentservice.v1.RefundPaymentRequest
+	1, // 8: paymentservice.v1.PaymentService.CreatePayment:output_type -> paymentservice.v1.CreatePaymentReply
+	1, // 9: paymentservice.v1.PaymentService.ChargeBooking:output_type -> paymentservice.v1.CreatePaymentReply
+	4, // 10: paymentservice.v1.PaymentService.RefundPayment:output_type -> paymentservice.v1.RefundPaymentReply
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_paymentservice_v1_payment_proto_init() }
@@ -221,7 +508,7 @@ func file_paymentservice_v1_payment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_paymentservice_v1_payment_proto_rawDesc), len(file_paymentservice_v1_payment_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
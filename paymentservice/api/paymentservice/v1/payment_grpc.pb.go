@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	PaymentService_CreatePayment_FullMethodName = "/paymentservice.v1.PaymentService/CreatePayment"
+	PaymentService_ChargeBooking_FullMethodName = "/paymentservice.v1.PaymentService/ChargeBooking"
+	PaymentService_RefundPayment_FullMethodName = "/paymentservice.v1.PaymentService/RefundPayment"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -28,6 +30,12 @@ const (
 type PaymentServiceClient interface {
 	// Create a payment record
 	CreatePayment(ctx context.Context, in *CreatePaymentRequest, opts ...grpc.CallOption) (*CreatePaymentReply, error)
+	// Charge a booking on top of its payment, e.g. after a seat change. Only
+	// bookingservice calls it, over gRPC; it has no HTTP route.
+	ChargeBooking(ctx context.Context, in *ChargeBookingRequest, opts ...grpc.CallOption) (*CreatePaymentReply, error)
+	// Refund part of a booking's paid payments. Only bookingservice calls
+	// it, over gRPC; it has no HTTP route.
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentReply, error)
 }
 
 type paymentServiceClient struct {
@@ -48,12 +56,38 @@ func (c *paymentServiceClient) CreatePayment(ctx context.Context, in *CreatePaym
 	return out, nil
 }
 
+func (c *paymentServiceClient) ChargeBooking(ctx context.Context, in *ChargeBookingRequest, opts ...grpc.CallOption) (*CreatePaymentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePaymentReply)
+	err := c.cc.Invoke(ctx, PaymentService_ChargeBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundPaymentReply)
+	err := c.cc.Invoke(ctx, PaymentService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
 type PaymentServiceServer interface {
 	// Create a payment record
 	CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentReply, error)
+	// Charge a booking on top of its payment, e.g. after a seat change. Only
+	// bookingservice calls it, over gRPC; it has no HTTP route.
+	ChargeBooking(context.Context, *ChargeBookingRequest) (*CreatePaymentReply, error)
+	// Refund part of a booking's paid payments. Only bookingservice calls
+	// it, over gRPC; it has no HTTP route.
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentReply, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -67,6 +101,12 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) CreatePayment(context.Context, *CreatePaymentRequest) (*CreatePaymentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePayment not implemented")
 }
+func (UnimplementedPaymentServiceServer) ChargeBooking(context.Context, *ChargeBookingRequest) (*CreatePaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeBooking not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -106,6 +146,42 @@ func _PaymentService_CreatePayment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ChargeBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ChargeBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ChargeBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ChargeBooking(ctx, req.(*ChargeBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +193,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePayment",
 			Handler:    _PaymentService_CreatePayment_Handler,
 		},
+		{
+			MethodName: "ChargeBooking",
+			Handler:    _PaymentService_ChargeBooking_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _PaymentService_RefundPayment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "paymentservice/v1/payment.proto",
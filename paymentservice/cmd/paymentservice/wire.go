@@ -22,6 +22,7 @@ func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*
 		service.NewPaymentService,   // Injects PaymentUsecase
 		server.NewGRPCServer,        // Injects PaymentService + confServer + logger
 		server.NewHTTPServer,        // Injects PaymentService + confServer + logger
+		server.NewBookingSyncer,     // Retries failed booking updates
 		newApp,                      // Combines GRPC + HTTP servers
 	)
 	return nil, nil, nil
@@ -25,22 +25,25 @@ import (
 // wireApp initializes PaymentService app.
 func wireApp(confServer *conf.Server, confData *conf.Data, logger log.Logger) (*kratos.App, func(), error) {
 	string2 := data.ProvideDSN(confData)
-	db, cleanup, err := data.NewDB(string2)
+	db, cleanup, err := data.NewDB(string2, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	paymentRepo := data.NewPaymentRepo(db)
-	bookingServiceClient, cleanup2, err := data.ProvideBookingClient()
+	refundRepo := data.NewRefundRepo(db)
+	idempotencyRepo := data.NewIdempotencyRepo(db)
+	bookingServiceClient, cleanup2, err := data.ProvideBookingClient(confData)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
 	}
 	bookingClient := data.NewBookingClient(bookingServiceClient)
-	paymentUsecase := biz.NewPaymentUsecase(paymentRepo, bookingClient)
+	paymentUsecase := biz.NewPaymentUsecase(paymentRepo, refundRepo, idempotencyRepo, bookingClient, logger)
 	paymentService := service.NewPaymentService(paymentUsecase)
 	grpcServer := server.NewGRPCServer(confServer, paymentService, logger)
 	httpServer := server.NewHTTPServer(confServer, paymentService, logger)
-	app := newApp(logger, grpcServer, httpServer)
+	bookingSyncer := server.NewBookingSyncer(paymentUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, bookingSyncer)
 	return app, func() {
 		cleanup2()
 		cleanup()
@@ -6,8 +6,11 @@ toolchain go1.24.5
 
 require (
 	bookingservice v0.0.0
+	eventservice v0.0.0
 	github.com/go-kratos/kratos/v2 v2.9.1
+	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/google/wire v0.6.0
+	github.com/rs/cors v1.11.1
 	go.uber.org/automaxprocs v1.5.1
 	google.golang.org/genproto/googleapis/api v0.0.0-20240528184218-531527333157
 	google.golang.org/grpc v1.65.0
@@ -18,6 +21,8 @@ require (
 
 replace bookingservice => ../bookingservice
 
+replace eventservice => ../eventservice
+
 require (
 	dario.cat/mergo v1.0.0 // indirect
 	github.com/fsnotify/fsnotify v1.6.0 // indirect
@@ -34,7 +39,6 @@ require (
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/kr/text v0.2.0 // indirect
-	github.com/rs/cors v1.11.1 // indirect
 	go.opentelemetry.io/otel v1.24.0 // indirect
 	go.opentelemetry.io/otel/metric v1.24.0 // indirect
 	go.opentelemetry.io/otel/trace v1.24.0 // indirect
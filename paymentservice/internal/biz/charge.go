@@ -0,0 +1,72 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	v1 "paymentservice/api/paymentservice/v1"
+)
+
+// ChargeOnce runs Charge once per idempotency key. A retry with the same key
+// and request returns the first charge; without a key every call charges
+// again.
+func (uc *PaymentUsecase) ChargeOnce(ctx context.Context, bookingID uint64, amount int64, currency, method, reason, key string) (*Payment, error) {
+	if key == "" {
+		return uc.Charge(ctx, bookingID, amount, currency, method, reason)
+	}
+
+	fp := fingerprint(strconv.FormatUint(bookingID, 10), strconv.FormatInt(amount, 10), currency, method, reason)
+	res, _, err := runIdempotent(ctx, uc.idempotency, "ChargeBooking", key, fp, func() ([]byte, error) {
+		payment, err := uc.Charge(ctx, bookingID, amount, currency, method, reason)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(payment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var payment Payment
+	if err := json.Unmarshal(res, &payment); err != nil {
+		return nil, fmt.Errorf("failed to decode stored charge: %w", err)
+	}
+	return &payment, nil
+}
+
+// Charge takes amount, in minor units, on top of a booking that has already
+// been paid for, using the original payment's method unless another is
+// given. The currency, when given, must be the original payment's.
+func (uc *PaymentUsecase) Charge(ctx context.Context, bookingID uint64, amount int64, currency, method, reason string) (*Payment, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("charge amount must be positive")
+	}
+	original, err := uc.repo.FindByBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if original.Status != "PAID" {
+		return nil, v1.ErrorPaymentNotFound("booking %d has no paid payment", bookingID)
+	}
+	if currency != "" && currency != original.Currency {
+		return nil, fmt.Errorf("booking %d was paid in %s, not %s", bookingID, original.Currency, currency)
+	}
+	if method == "" {
+		method = original.Method
+	}
+
+	// A supplementary charge goes on the method the customer already paid
+	// with, so it is taken as PAID straight away.
+	return uc.repo.Save(ctx, &Payment{
+		BookingID: bookingID,
+		Kind:      KindCharge,
+		Amount:    amount,
+		Currency:  original.Currency,
+		Method:    method,
+		Status:    "PAID",
+		CreatedAt: time.Now(),
+	})
+}
@@ -2,60 +2,132 @@ package biz
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 	"math/rand"
+	"strconv"
 
-	
-	
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// bookingSyncBatch is how many payments SyncBookings moves bookings for at
+// a time.
+const bookingSyncBatch = 50
+
+// ErrBookingMoved is returned by BookingClient.UpdateBookingStatus when the
+// booking is no longer in a status the update can move it from.
+var ErrBookingMoved = errors.New("booking has already moved on")
+
+// Kinds of payment. A booking is paid for by one payment; a charge is
+// taken on top of it later, e.g. for dearer seats.
+const (
+	KindPayment = "payment"
+	KindCharge  = "charge"
 )
 
 // Payment entity
 type Payment struct {
 	ID        uint64
 	BookingID uint64
-	Amount    float64
+	Kind      string
+	Amount    int64 // in minor units of Currency
+	Currency  string
 	Method    string
 	Status    string
 	CreatedAt time.Time
+	// BookingPending is set while the booking has not been moved to match
+	// the payment's outcome yet.
+	BookingPending bool
 }
 
 
 // PaymentRepo interface
 type PaymentRepo interface {
 	Save(ctx context.Context, p *Payment) (*Payment, error)
-	UpdateStatus(ctx context.Context, bookingID uint64, status string) error
+	// UpdateStatus sets the status of payment id.
+	UpdateStatus(ctx context.Context, id uint64, status string) error
+	// ListBookingPending returns up to limit PAID or FAILED payments whose
+	// booking has not been moved yet, oldest first.
+	ListBookingPending(ctx context.Context, limit int) ([]*Payment, error)
+	// MarkBookingDone records that payment id's booking has been moved.
+	MarkBookingDone(ctx context.Context, id uint64) error
+	// FindByBooking returns the payment the booking was paid for with: its
+	// first PAID one, or its latest if none was paid, leaving out charges.
+	// It fails with ErrorPaymentNotFound if there is none.
 	FindByBooking(ctx context.Context, bookingID uint64) (*Payment, error)
 }
 
 // BookingClient interface to fetch booking info
 type BookingClient interface {
 	GetBooking(ctx context.Context, bookingID uint64) (*Booking, error)
-	UpdateBookingStatus(ctx context.Context, bookingID uint64, status string) error
+	// UpdateBookingStatus moves the booking to status on behalf of payment
+	// paymentID, giving reason for its status history.
+	UpdateBookingStatus(ctx context.Context, bookingID, paymentID uint64, status, reason string) error
 }
 
 // Booking struct (minimal fields for payment)
 type Booking struct {
 	ID        uint64
 	UserID    uint64
-	TotalCost float64
+	TotalCost int64 // in minor units of Currency
+	Currency  string
 	Status    string
 }
 
 // PaymentUsecase
 type PaymentUsecase struct {
 	repo          PaymentRepo
+	refunds       RefundRepo
+	idempotency   IdempotencyRepo
 	bookingClient BookingClient
+	log           *log.Helper
 }
 
-func NewPaymentUsecase(repo PaymentRepo, bc BookingClient) *PaymentUsecase {
+func NewPaymentUsecase(repo PaymentRepo, refunds RefundRepo, idempotency IdempotencyRepo, bc BookingClient, logger log.Logger) *PaymentUsecase {
 	return &PaymentUsecase{
 		repo:          repo,
+		refunds:       refunds,
+		idempotency:   idempotency,
 		bookingClient: bc,
+		log:           log.NewHelper(logger),
+	}
+}
+
+// ProcessPaymentOnce runs ProcessPayment once per booking and idempotency
+// key, so different bookings' keys never collide. A retry with the same key
+// and request returns the first payment instead of charging again; without
+// a key it behaves like ProcessPayment.
+func (uc *PaymentUsecase) ProcessPaymentOnce(ctx context.Context, bookingID uint64, method, key string) (*Payment, error) {
+	if key == "" {
+		return uc.ProcessPayment(ctx, bookingID, method)
+	}
+
+	scope := fmt.Sprintf("CreatePayment/%d", bookingID)
+	fp := fingerprint(strconv.FormatUint(bookingID, 10), method)
+	res, _, err := runIdempotent(ctx, uc.idempotency, scope, key, fp, func() ([]byte, error) {
+		payment, err := uc.ProcessPayment(ctx, bookingID, method)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(payment)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var payment Payment
+	if err := json.Unmarshal(res, &payment); err != nil {
+		return nil, fmt.Errorf("failed to decode stored payment: %w", err)
 	}
+	return &payment, nil
 }
 
-// ProcessPayment creates payment in DB and updates status
+// ProcessPayment creates payment in DB and updates status. Once the
+// outcome is recorded the payment is returned even if the booking could not
+// be moved yet, so that a retry under the same idempotency key does not
+// charge again; SyncBookings moves the booking later.
 func (uc *PaymentUsecase) ProcessPayment(ctx context.Context, bookingID uint64, method string) (*Payment, error) {
 	// 1️⃣ Fetch booking from BookingService
 	booking, err := uc.bookingClient.GetBooking(ctx, bookingID)
@@ -65,11 +137,14 @@ func (uc *PaymentUsecase) ProcessPayment(ctx context.Context, bookingID uint64,
 
 	// 2️⃣ Create payment record with PENDING status
 	payment := &Payment{
-		BookingID: bookingID,
-		Amount:    booking.TotalCost, // amount comes from booking
-		Method:    method,
-		Status:    "PENDING",
-		CreatedAt: time.Now(),
+		BookingID:      bookingID,
+		Kind:           KindPayment,
+		Amount:         booking.TotalCost, // amount comes from booking
+		Currency:       booking.Currency,
+		Method:         method,
+		Status:         "PENDING",
+		CreatedAt:      time.Now(),
+		BookingPending: true,
 	}
 
 	savedPayment, err := uc.repo.Save(ctx, payment)
@@ -82,23 +157,59 @@ func (uc *PaymentUsecase) ProcessPayment(ctx context.Context, bookingID uint64,
 
 	if success {
 		savedPayment.Status = "PAID"
-		booking.Status = "CONFIRMED"
 	} else {
 		savedPayment.Status = "FAILED"
-		booking.Status = "CANCELLED"
 	}
 
 	// 4️⃣ Update payment status in database
-	if err := uc.repo.UpdateStatus(ctx, bookingID, savedPayment.Status); err != nil {
+	if err := uc.repo.UpdateStatus(ctx, savedPayment.ID, savedPayment.Status); err != nil {
 		return nil, fmt.Errorf("failed to update payment status: %w", err)
 	}
 
-	// 5️⃣ Update booking status in BookingService via gRPC
-	err = uc.bookingClient.UpdateBookingStatus(ctx, bookingID, booking.Status)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to update booking status: %w", err)
+	// 5️⃣ Update booking status in BookingService via gRPC; if that fails
+	// the payment stands and SyncBookings retries
+	if err := uc.moveBooking(ctx, savedPayment); err != nil {
+		uc.log.Errorf("Failed to update booking %d after payment %d, will retry: %v", bookingID, savedPayment.ID, err)
 	}
 
 	return savedPayment, nil
+}
+
+// moveBooking confirms or cancels payment's booking to match its outcome
+// and records that it did. A booking that already moved on is left alone.
+func (uc *PaymentUsecase) moveBooking(ctx context.Context, payment *Payment) error {
+	status := "CANCELLED"
+	if payment.Status == "PAID" {
+		status = "CONFIRMED"
+	}
+	reason := fmt.Sprintf("payment %s via %s", payment.Status, payment.Method)
+	err := uc.bookingClient.UpdateBookingStatus(ctx, payment.BookingID, payment.ID, status, reason)
+	if errors.Is(err, ErrBookingMoved) {
+		uc.log.Warnf("Booking %d had already moved on when payment %d %s", payment.BookingID, payment.ID, payment.Status)
+	} else if err != nil {
+		return err
+	}
+	if err := uc.repo.MarkBookingDone(ctx, payment.ID); err != nil {
+		return err
+	}
+	payment.BookingPending = false
+	return nil
+}
+
+// SyncBookings moves the bookings of payments whose booking update failed,
+// and returns how many it moved.
+func (uc *PaymentUsecase) SyncBookings(ctx context.Context) (int, error) {
+	pending, err := uc.repo.ListBookingPending(ctx, bookingSyncBatch)
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, p := range pending {
+		if err := uc.moveBooking(ctx, p); err != nil {
+			uc.log.Errorf("Failed to update booking %d after payment %d: %v", p.BookingID, p.ID, err)
+			continue
+		}
+		moved++
+	}
+	return moved, nil
 }
\ No newline at end of file
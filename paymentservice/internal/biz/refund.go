@@ -0,0 +1,74 @@
+package biz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Refund gives back part of what was paid for a booking.
+type Refund struct {
+	ID        uint64
+	PaymentID uint64
+	BookingID uint64
+	Amount    int64 // in minor units of Currency
+	Currency  string
+	Reason    string
+	Status    string
+	CreatedAt time.Time
+	// RefundedTotal is the sum of the booking's refunds, this one included.
+	RefundedTotal int64
+}
+
+// RefundRepo stores refunds.
+type RefundRepo interface {
+	// Create records a refund against the booking's PAID payments. It fails
+	// with ErrorPaymentNotFound when there are none, and with
+	// ErrorRefundExceedsPayment when the booking's refunds would add up to
+	// more than was paid. A refund without a currency takes the payments'.
+	Create(ctx context.Context, r *Refund) (*Refund, error)
+}
+
+// RefundOnce refunds amount of the booking's payments once per idempotency
+// key. A retry with the same key and request returns the first refund;
+// without a key every call refunds again.
+func (uc *PaymentUsecase) RefundOnce(ctx context.Context, bookingID uint64, amount int64, currency, reason, key string) (*Refund, error) {
+	if key == "" {
+		return uc.Refund(ctx, bookingID, amount, currency, reason)
+	}
+
+	fp := fingerprint(strconv.FormatUint(bookingID, 10), strconv.FormatInt(amount, 10), currency, reason)
+	res, _, err := runIdempotent(ctx, uc.idempotency, "RefundPayment", key, fp, func() ([]byte, error) {
+		refund, err := uc.Refund(ctx, bookingID, amount, currency, reason)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(refund)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var refund Refund
+	if err := json.Unmarshal(res, &refund); err != nil {
+		return nil, fmt.Errorf("failed to decode stored refund: %w", err)
+	}
+	return &refund, nil
+}
+
+// Refund gives amount, in minor units, of the booking's paid payments back.
+func (uc *PaymentUsecase) Refund(ctx context.Context, bookingID uint64, amount int64, currency, reason string) (*Refund, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("refund amount must be positive")
+	}
+	return uc.refunds.Create(ctx, &Refund{
+		BookingID: bookingID,
+		Amount:    amount,
+		Currency:  currency,
+		Reason:    reason,
+		Status:    "REFUNDED",
+		CreatedAt: time.Now(),
+	})
+}
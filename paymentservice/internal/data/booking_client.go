@@ -12,11 +12,16 @@ type bookingClient struct {
 }
 
 
-func (b *bookingClient) UpdateBookingStatus(ctx context.Context, bookingID uint64, status string) error {
+func (b *bookingClient) UpdateBookingStatus(ctx context.Context, bookingID, paymentID uint64, status, reason string) error {
 	_, err := b.client.UpdateBooking(ctx, &bookingv1.UpdateBookingRequest{
-		Id:     bookingID,
-		Status: status,
+		Id:        bookingID,
+		Status:    bookingv1.BookingStatus(bookingv1.BookingStatus_value[status]),
+		PaymentId: paymentID,
+		Reason:    reason,
 	})
+	if bookingv1.IsInvalidStatusTransition(err) {
+		return biz.ErrBookingMoved
+	}
 	return err
 }
 
@@ -28,7 +33,8 @@ func (b *bookingClient) GetBooking(ctx context.Context, bookingID uint64) (*biz.
     return &biz.Booking{
         ID:        res.Booking.Id,
         UserID:    res.Booking.UserId,
-        TotalCost: float64(res.Booking.TotalCost),
-        Status:    res.Booking.Status,
+        TotalCost: res.Booking.GetTotalCost().GetAmountMinor(),
+        Currency:  res.Booking.GetTotalCost().GetCurrency(),
+        Status:    res.Booking.Status.String(),
     }, nil
 }
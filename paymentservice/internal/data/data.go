@@ -2,17 +2,23 @@ package data
 
 import (
 	"context"
+	"fmt"
+	"time"
 	
 	"paymentservice/internal/biz"
 	"paymentservice/internal/conf"
 
 	bookingv1 "bookingservice/api/bookingservice/v1"
+	"eventservice/pkg/money"
 
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
 	"github.com/google/wire"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	gormlogger "gorm.io/gorm/logger"
 )
 
 // ProviderSet wires data dependencies
@@ -21,6 +27,8 @@ var ProviderSet = wire.NewSet(
 	NewDB,
 	ProvideBookingClient,
 	NewPaymentRepo,
+	NewRefundRepo,
+	NewIdempotencyRepo,
 	NewBookingClient,
 )
 
@@ -45,9 +53,9 @@ func NewData(paymentRepo biz.PaymentRepo) (*Data, func(), error) {
 }
 
 // NewDB creates a GORM DB connection
-func NewDB(dsn string) (*gorm.DB, func(), error) {
+func NewDB(dsn string, logger log.Logger) (*gorm.DB, func(), error) {
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: gormlogger.Default.LogMode(gormlogger.Info),
 	})
 	if err != nil {
 		return nil, nil, err
@@ -57,19 +65,43 @@ func NewDB(dsn string) (*gorm.DB, func(), error) {
 	sqlDB.SetMaxOpenConns(100)
 
 	// 👉 Run AutoMigrate here
-	if err := db.AutoMigrate(&PaymentModel{}); err != nil {
+	if err := db.AutoMigrate(&PaymentModel{}, &RefundModel{}, &IdempotencyKey{}); err != nil {
 		return nil, nil, err
 	}
+	// Move amounts of older payments and refunds over to minor units
+	for _, table := range []string{"payment_models", "refund_models"} {
+		moved, err := money.MigrateMinorUnits(db, table, "amount", "amount_minor")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to backfill %s: %w", table, err)
+		}
+		if moved > 0 {
+			log.NewHelper(logger).Infof("Backfilled amount_minor for %d rows of %s", moved, table)
+		}
+	}
 
 	cleanup := func() { _ = sqlDB.Close() }
 	return db, cleanup, nil
 }
 
-
-// ProvideBookingClient creates a gRPC BookingService client
-func ProvideBookingClient() (bookingv1.BookingServiceClient, func(), error) {
+// ProvideBookingClient creates a gRPC BookingService client. Every call
+// carries a short-lived service token, which bookingservice requires of
+// UpdateBooking.
+func ProvideBookingClient(c *conf.Data) (bookingv1.BookingServiceClient, func(), error) {
+	key := []byte(c.GetBooking().GetServiceKey())
 	ctx := context.Background()
-	conn, err := grpc.DialInsecure(ctx, grpc.WithEndpoint("127.0.0.1:9002"))
+	conn, err := grpc.DialInsecure(ctx,
+		grpc.WithEndpoint("127.0.0.1:9002"),
+		grpc.WithMiddleware(jwt.Client(
+			func(*jwtv5.Token) (interface{}, error) { return key, nil },
+			jwt.WithClaims(func() jwtv5.Claims {
+				return jwtv5.MapClaims{
+					"sub":  "paymentservice",
+					"role": "service",
+					"exp":  time.Now().Add(time.Minute).Unix(),
+				}
+			}),
+		)),
+	)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"paymentservice/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyKey DB model
+type IdempotencyKey struct {
+	Scope       string `gorm:"primaryKey;size:64"`
+	Key         string `gorm:"primaryKey;size:128"`
+	Fingerprint string `gorm:"size:64;not null"`
+	Response    []byte
+	CreatedAt   time.Time `gorm:"index"`
+	UpdatedAt   time.Time
+}
+
+type idempotencyRepo struct {
+	data *gorm.DB
+}
+
+func NewIdempotencyRepo(db *gorm.DB) biz.IdempotencyRepo {
+	return &idempotencyRepo{data: db}
+}
+
+func (r *idempotencyRepo) Claim(ctx context.Context, scope, key, fingerprint string, ttl, claimTimeout time.Duration) (*biz.IdempotencyRecord, bool, error) {
+	now := time.Now()
+	row := &IdempotencyKey{Scope: scope, Key: key, Fingerprint: fingerprint, CreatedAt: now, UpdatedAt: now}
+	res := r.data.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(row)
+	if res.Error != nil {
+		return nil, false, res.Error
+	}
+	if res.RowsAffected == 1 {
+		return nil, true, nil
+	}
+
+	var existing IdempotencyKey
+	if err := r.data.WithContext(ctx).Where("scope = ? AND key = ?", scope, key).First(&existing).Error; err != nil {
+		return nil, false, err
+	}
+
+	expired := existing.CreatedAt.Before(now.Add(-ttl))
+	abandoned := len(existing.Response) == 0 && existing.UpdatedAt.Before(now.Add(-claimTimeout))
+	if expired || abandoned {
+		// Take the key over only if nobody else did since we read it.
+		res := r.data.WithContext(ctx).Model(&IdempotencyKey{}).
+			Where("scope = ? AND key = ? AND updated_at = ?", scope, key, existing.UpdatedAt).
+			Updates(map[string]interface{}{
+				"fingerprint": fingerprint,
+				"response":    nil,
+				"created_at":  now,
+				"updated_at":  now,
+			})
+		if res.Error != nil {
+			return nil, false, res.Error
+		}
+		if res.RowsAffected == 1 {
+			return nil, true, nil
+		}
+	}
+
+	return &biz.IdempotencyRecord{
+		Fingerprint: existing.Fingerprint,
+		Response:    existing.Response,
+		CreatedAt:   existing.CreatedAt,
+	}, false, nil
+}
+
+func (r *idempotencyRepo) Complete(ctx context.Context, scope, key string, response []byte) error {
+	return r.data.WithContext(ctx).Model(&IdempotencyKey{}).
+		Where("scope = ? AND key = ?", scope, key).
+		Updates(map[string]interface{}{"response": response, "updated_at": time.Now()}).Error
+}
+
+func (r *idempotencyRepo) Release(ctx context.Context, scope, key string) error {
+	return r.data.WithContext(ctx).
+		Where("scope = ? AND key = ?", scope, key).
+		Delete(&IdempotencyKey{}).Error
+}
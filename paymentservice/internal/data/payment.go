@@ -2,8 +2,10 @@ package data
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	v1 "paymentservice/api/paymentservice/v1"
 	"paymentservice/internal/biz"
 
 	"gorm.io/gorm"
@@ -11,12 +13,17 @@ import (
 
 // DB model
 type PaymentModel struct {
-	ID        uint64  `gorm:"primaryKey;autoIncrement"`
-	BookingID uint64  `gorm:"not null;index"`
-	Amount    float64 `gorm:"not null"`
-	Method    string  `gorm:"size:20"`
-	Status    string  `gorm:"size:20;not null"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	BookingID   uint64    `gorm:"not null;index"`
+	Kind        string    `gorm:"size:16;not null;default:'payment'"`
+	AmountMinor int64     `gorm:"not null;default:0"`
+	Currency    string    `gorm:"size:3;not null;default:'INR'"`
+	Method      string    `gorm:"size:20"`
+	Status      string    `gorm:"size:20;not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	// BookingPending is set until the booking has been moved to match the
+	// payment's outcome.
+	BookingPending bool `gorm:"not null;default:false;index"`
 }
 
 type paymentRepo struct {
@@ -29,11 +36,14 @@ func NewPaymentRepo(db *gorm.DB) biz.PaymentRepo {
 
 func (r *paymentRepo) Save(ctx context.Context, p *biz.Payment) (*biz.Payment, error) {
 	model := &PaymentModel{
-		BookingID: p.BookingID,
-		Amount:    p.Amount,
-		Method:    p.Method,
-		Status:    p.Status,
-		CreatedAt: p.CreatedAt,
+		BookingID:      p.BookingID,
+		Kind:           p.Kind,
+		AmountMinor:    p.Amount,
+		Currency:       p.Currency,
+		Method:         p.Method,
+		Status:         p.Status,
+		CreatedAt:      p.CreatedAt,
+		BookingPending: p.BookingPending,
 	}
 	err := r.data.WithContext(ctx).Create(model).Error
 	if err != nil {
@@ -43,25 +53,62 @@ func (r *paymentRepo) Save(ctx context.Context, p *biz.Payment) (*biz.Payment, e
 	return p, nil
 }
 
-func (r *paymentRepo) UpdateStatus(ctx context.Context, bookingID uint64, status string) error {
+func (r *paymentRepo) UpdateStatus(ctx context.Context, id uint64, status string) error {
 	return r.data.WithContext(ctx).
 		Model(&PaymentModel{}).
-		Where("booking_id = ?", bookingID).
+		Where("id = ?", id).
 		Update("status", status).Error
 }
 
+func (r *paymentRepo) ListBookingPending(ctx context.Context, limit int) ([]*biz.Payment, error) {
+	var models []PaymentModel
+	err := r.data.WithContext(ctx).
+		Where("booking_pending AND status IN ?", []string{"PAID", "FAILED"}).
+		Order("id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*biz.Payment, 0, len(models))
+	for i := range models {
+		res = append(res, toPayment(&models[i]))
+	}
+	return res, nil
+}
+
+func (r *paymentRepo) MarkBookingDone(ctx context.Context, id uint64) error {
+	return r.data.WithContext(ctx).
+		Model(&PaymentModel{}).
+		Where("id = ?", id).
+		Update("booking_pending", false).Error
+}
+
 func (r *paymentRepo) FindByBooking(ctx context.Context, bookingID uint64) (*biz.Payment, error) {
 	var model PaymentModel
-	err := r.data.WithContext(ctx).Where("booking_id = ?", bookingID).First(&model).Error
+	err := r.data.WithContext(ctx).
+		Where("booking_id = ? AND kind = ?", bookingID, biz.KindPayment).
+		Order("CASE WHEN status = 'PAID' THEN 0 ELSE 1 END, CASE WHEN status = 'PAID' THEN id ELSE -id END").
+		First(&model).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorPaymentNotFound("booking %d has no payment", bookingID)
+		}
 		return nil, err
 	}
+	return toPayment(&model), nil
+}
+
+func toPayment(m *PaymentModel) *biz.Payment {
 	return &biz.Payment{
-		ID:        model.ID,
-		BookingID: model.BookingID,
-		Amount:    model.Amount,
-		Method:    model.Method,
-		Status:    model.Status,
-		CreatedAt: model.CreatedAt,
-	}, nil
+		ID:             m.ID,
+		BookingID:      m.BookingID,
+		Kind:           m.Kind,
+		Amount:         m.AmountMinor,
+		Currency:       m.Currency,
+		Method:         m.Method,
+		Status:         m.Status,
+		CreatedAt:      m.CreatedAt,
+		BookingPending: m.BookingPending,
+	}
 }
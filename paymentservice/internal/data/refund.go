@@ -0,0 +1,94 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "paymentservice/api/paymentservice/v1"
+	"paymentservice/internal/biz"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RefundModel DB model
+type RefundModel struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	PaymentID   uint64 `gorm:"not null;index"`
+	BookingID   uint64 `gorm:"not null;index"`
+	AmountMinor int64  `gorm:"not null;default:0"`
+	Currency    string `gorm:"size:3;not null;default:'INR'"`
+	Reason      string
+	Status      string    `gorm:"size:20;not null"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+type refundRepo struct {
+	data *gorm.DB
+}
+
+func NewRefundRepo(db *gorm.DB) biz.RefundRepo {
+	return &refundRepo{data: db}
+}
+
+// Create locks the booking's paid payments while it checks and records the
+// refund, so concurrent refunds cannot together exceed them. The refund is
+// recorded against the latest payment.
+func (r *refundRepo) Create(ctx context.Context, refund *biz.Refund) (*biz.Refund, error) {
+	err := r.data.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var payments []PaymentModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("booking_id = ? AND status = ?", refund.BookingID, "PAID").
+			Order("id").
+			Find(&payments).Error; err != nil {
+			return err
+		}
+		if len(payments) == 0 {
+			return v1.ErrorPaymentNotFound("booking %d has no paid payment", refund.BookingID)
+		}
+		currency := payments[0].Currency
+		if refund.Currency != "" && refund.Currency != currency {
+			return fmt.Errorf("booking %d was paid in %s, not %s", refund.BookingID, currency, refund.Currency)
+		}
+		var paid int64
+		for _, p := range payments {
+			paid += p.AmountMinor
+		}
+		paymentID := payments[len(payments)-1].ID
+
+		var refunded int64
+		if err := tx.Model(&RefundModel{}).
+			Select("COALESCE(SUM(amount_minor), 0)").
+			Where("booking_id = ?", refund.BookingID).
+			Scan(&refunded).Error; err != nil {
+			return err
+		}
+		if refunded+refund.Amount > paid {
+			return v1.ErrorRefundExceedsPayment("refund of %d exceeds the %d %s left of booking %d's payments",
+				refund.Amount, paid-refunded, currency, refund.BookingID)
+		}
+
+		model := &RefundModel{
+			PaymentID:   paymentID,
+			BookingID:   refund.BookingID,
+			AmountMinor: refund.Amount,
+			Currency:    currency,
+			Reason:      refund.Reason,
+			Status:      refund.Status,
+			CreatedAt:   refund.CreatedAt,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+		refund.ID = model.ID
+		refund.PaymentID = paymentID
+		refund.Currency = currency
+		refund.RefundedTotal = refunded + refund.Amount
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refund, nil
+}
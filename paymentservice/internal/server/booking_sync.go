@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"paymentservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// bookingSyncInterval is how often failed booking updates are retried.
+const bookingSyncInterval = 30 * time.Second
+
+// BookingSyncer retries the booking updates of payments whose booking
+// could not be moved when they were made. It implements transport.Server so
+// it starts and stops with the kratos app.
+type BookingSyncer struct {
+	uc   *biz.PaymentUsecase
+	log  *log.Helper
+	stop chan struct{}
+}
+
+// NewBookingSyncer creates the background booking syncer.
+func NewBookingSyncer(uc *biz.PaymentUsecase, logger log.Logger) *BookingSyncer {
+	return &BookingSyncer{
+		uc:   uc,
+		log:  log.NewHelper(logger),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start blocks, syncing every interval, until ctx is done or Stop is called.
+func (s *BookingSyncer) Start(ctx context.Context) error {
+	s.log.Infof("[booking-sync] retrying booking updates every %s", bookingSyncInterval)
+	runEvery(ctx, s.stop, bookingSyncInterval, func(ctx context.Context) {
+		n, err := s.uc.SyncBookings(ctx)
+		if err != nil {
+			s.log.Errorf("[booking-sync] sync failed: %v", err)
+			return
+		}
+		if n > 0 {
+			s.log.Infof("[booking-sync] updated %d bookings", n)
+		}
+	})
+	return nil
+}
+
+// Stop ends the sync loop.
+func (s *BookingSyncer) Stop(ctx context.Context) error {
+	close(s.stop)
+	return nil
+}
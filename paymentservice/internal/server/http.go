@@ -17,7 +17,7 @@ func NewHTTPServer(c *conf.Server, paymentService *service.PaymentService, logge
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"http://localhost:5173"}, // your frontend origin
 		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowedHeaders:   []string{"Origin", "Content-Type", "Accept", "Authorization", "Idempotency-Key"},
 		AllowCredentials: true,
 	})
 
@@ -0,0 +1,22 @@
+package server
+
+import (
+	"context"
+	"time"
+)
+
+// runEvery calls fn every interval until ctx is done or stop is closed.
+func runEvery(ctx context.Context, stop <-chan struct{}, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	moneyv1 "eventservice/api/money/v1"
 	pb "paymentservice/api/paymentservice/v1"
 	"paymentservice/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 type PaymentService struct {
@@ -16,7 +19,11 @@ func NewPaymentService(uc *biz.PaymentUsecase) *PaymentService {
 }
 
 func (s *PaymentService) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentReply, error) {
-	payment, err := s.uc.ProcessPayment(ctx, req.BookingId, req.PaymentMethod)
+	key := req.IdempotencyKey
+	if key == "" {
+		key = idempotencyKey(ctx)
+	}
+	payment, err := s.uc.ProcessPaymentOnce(ctx, req.BookingId, req.PaymentMethod, key)
 	if err != nil {
 		return nil, err
 	}
@@ -24,9 +31,59 @@ func (s *PaymentService) CreatePayment(ctx context.Context, req *pb.CreatePaymen
 	return &pb.CreatePaymentReply{
 		PaymentId: payment.ID,
 		BookingId: payment.BookingID,
-		Amount:    float32(payment.Amount),
+		Amount:    &moneyv1.Money{AmountMinor: payment.Amount, Currency: payment.Currency},
 		Method:    payment.Method,
 		Status:    payment.Status,
 		CreatedAt: payment.CreatedAt.Format("2006-01-02 15:04:05"),
 	}, nil
 }
+
+// idempotencyKey reads the Idempotency-Key header (or gRPC metadata) of the
+// incoming request.
+func idempotencyKey(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("Idempotency-Key")
+	}
+	return ""
+}
+
+func (s *PaymentService) ChargeBooking(ctx context.Context, req *pb.ChargeBookingRequest) (*pb.CreatePaymentReply, error) {
+	key := req.IdempotencyKey
+	if key == "" {
+		key = idempotencyKey(ctx)
+	}
+	payment, err := s.uc.ChargeOnce(ctx, req.BookingId, req.GetAmount().GetAmountMinor(), req.GetAmount().GetCurrency(), req.PaymentMethod, req.Reason, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePaymentReply{
+		PaymentId: payment.ID,
+		BookingId: payment.BookingID,
+		Amount:    &moneyv1.Money{AmountMinor: payment.Amount, Currency: payment.Currency},
+		Method:    payment.Method,
+		Status:    payment.Status,
+		CreatedAt: payment.CreatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
+
+func (s *PaymentService) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentReply, error) {
+	key := req.IdempotencyKey
+	if key == "" {
+		key = idempotencyKey(ctx)
+	}
+	refund, err := s.uc.RefundOnce(ctx, req.BookingId, req.GetAmount().GetAmountMinor(), req.GetAmount().GetCurrency(), req.Reason, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefundPaymentReply{
+		RefundId:      refund.ID,
+		PaymentId:     refund.PaymentID,
+		BookingId:     refund.BookingID,
+		Amount:        &moneyv1.Money{AmountMinor: refund.Amount, Currency: refund.Currency},
+		RefundedTotal: &moneyv1.Money{AmountMinor: refund.RefundedTotal, Currency: refund.Currency},
+		Status:        refund.Status,
+		CreatedAt:     refund.CreatedAt.Format("2006-01-02 15:04:05"),
+	}, nil
+}
@@ -31,6 +31,7 @@ type User struct {
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	PasswordHash  string                 `protobuf:"bytes,4,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"` // stored securely (bcrypt/argon2)
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"` // "staff" or "admin", set directly in the database; empty for customers
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -100,13 +101,19 @@ func (x *User) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 // Create
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // plain password (to be hashed)
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,13 +169,6 @@ func (x *CreateUserRequest) GetPassword() string {
 	return ""
 }
 
-func (x *CreateUserRequest) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
-}
-
 type UserReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -274,6 +274,51 @@ func (x *GetUserRequest) GetId() uint64 {
 	return 0
 }
 
+// Get by email
+type GetUserByEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserByEmailRequest) Reset() {
+	*x = GetUserByEmailRequest{}
+	mi := &file_userservice_v1_users_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserByEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByEmailRequest) ProtoMessage() {}
+
+func (x *GetUserByEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_userservice_v1_users_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailRequest) Descriptor() ([]byte, []int) {
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserByEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 // Update
 type UpdateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -287,7 +332,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_userservice_v1_users_proto_msgTypes[4]
+	mi := &file_userservice_v1_users_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +344,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[4]
+	mi := &file_userservice_v1_users_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +357,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{4}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateUserRequest) GetId() uint64 {
@@ -353,7 +398,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_userservice_v1_users_proto_msgTypes[5]
+	mi := &file_userservice_v1_users_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +410,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[5]
+	mi := &file_userservice_v1_users_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +423,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{5}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteUserRequest) GetId() uint64 {
@@ -397,7 +442,7 @@ type DeleteUserReply struct {
 
 func (x *DeleteUserReply) Reset() {
 	*x = DeleteUserReply{}
-	mi := &file_userservice_v1_users_proto_msgTypes[6]
+	mi := &file_userservice_v1_users_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +454,7 @@ func (x *DeleteUserReply) String() string {
 func (*DeleteUserReply) ProtoMessage() {}
 
 func (x *DeleteUserReply) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[6]
+	mi := &file_userservice_v1_users_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +467,7 @@ func (x *DeleteUserReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserReply.ProtoReflect.Descriptor instead.
 func (*DeleteUserReply) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{6}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteUserReply) GetSuccess() bool {
@@ -441,7 +486,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_userservice_v1_users_proto_msgTypes[7]
+	mi := &file_userservice_v1_users_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +498,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[7]
+	mi := &file_userservice_v1_users_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +511,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{7}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{8}
 }
 
 type ListUsersReply struct {
@@ -478,7 +523,7 @@ type ListUsersReply struct {
 
 func (x *ListUsersReply) Reset() {
 	*x = ListUsersReply{}
-	mi := &file_userservice_v1_users_proto_msgTypes[8]
+	mi := &file_userservice_v1_users_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +535,7 @@ func (x *ListUsersReply) String() string {
 func (*ListUsersReply) ProtoMessage() {}
 
 func (x *ListUsersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[8]
+	mi := &file_userservice_v1_users_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +548,7 @@ func (x *ListUsersReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersReply.ProtoReflect.Descriptor instead.
 func (*ListUsersReply) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{8}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUsersReply) GetUsers() []*User {
@@ -524,7 +569,7 @@ type LoginUserRequest struct {
 
 func (x *LoginUserRequest) Reset() {
 	*x = LoginUserRequest{}
-	mi := &file_userservice_v1_users_proto_msgTypes[9]
+	mi := &file_userservice_v1_users_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +581,7 @@ func (x *LoginUserRequest) String() string {
 func (*LoginUserRequest) ProtoMessage() {}
 
 func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[9]
+	mi := &file_userservice_v1_users_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +594,7 @@ func (x *LoginUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginUserRequest.ProtoReflect.Descriptor instead.
 func (*LoginUserRequest) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{9}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LoginUserRequest) GetEmail() string {
@@ -576,7 +621,7 @@ type AuthReply struct {
 
 func (x *AuthReply) Reset() {
 	*x = AuthReply{}
-	mi := &file_userservice_v1_users_proto_msgTypes[10]
+	mi := &file_userservice_v1_users_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +633,7 @@ func (x *AuthReply) String() string {
 func (*AuthReply) ProtoMessage() {}
 
 func (x *AuthReply) ProtoReflect() protoreflect.Message {
-	mi := &file_userservice_v1_users_proto_msgTypes[10]
+	mi := &file_userservice_v1_users_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +646,7 @@ func (x *AuthReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthReply.ProtoReflect.Descriptor instead.
 func (*AuthReply) Descriptor() ([]byte, []int) {
-	return file_userservice_v1_users_proto_rawDescGZIP(), []int{10}
+	return file_userservice_v1_users_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AuthReply) GetToken() string {
@@ -622,25 +667,27 @@ var File_userservice_v1_users_proto protoreflect.FileDescriptor
 
 const file_userservice_v1_users_proto_rawDesc = "" +
 	"\n" +
-	"\x1auserservice/v1/users.proto\x12\busers.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x01\n" +
+	"\x1auserservice/v1/users.proto\x12\busers.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\x12#\n" +
 	"\rpassword_hash\x18\x04 \x01(\tR\fpasswordHash\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"m\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\"Y\n" +
 	"\x11CreateUserRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\"E\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"E\n" +
 	"\tUserReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05email\x18\x03 \x01(\tR\x05email\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x98\x01\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"-\n" +
+	"\x15GetUserByEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x98\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
@@ -661,11 +708,12 @@ const file_userservice_v1_users_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"E\n" +
 	"\tAuthReply\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\"\n" +
-	"\x04user\x18\x02 \x01(\v2\x0e.users.v1.UserR\x04user2\x8c\x04\n" +
+	"\x04user\x18\x02 \x01(\v2\x0e.users.v1.UserR\x04user2\xed\x04\n" +
 	"\vUserService\x12Q\n" +
 	"\n" +
 	"CreateUser\x12\x1b.users.v1.CreateUserRequest\x1a\x13.users.v1.UserReply\"\x11\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/users\x12M\n" +
-	"\aGetUser\x12\x18.users.v1.GetUserRequest\x1a\x13.users.v1.UserReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/users/{id}\x12V\n" +
+	"\aGetUser\x12\x18.users.v1.GetUserRequest\x1a\x13.users.v1.UserReply\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/users/{id}\x12_\n" +
+	"\x0eGetUserByEmail\x12\x1f.users.v1.GetUserByEmailRequest\x1a\x13.users.v1.UserReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/users/by-email\x12V\n" +
 	"\n" +
 	"UpdateUser\x12\x1b.users.v1.UpdateUserRequest\x1a\x13.users.v1.UserReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/users/{id}\x12Y\n" +
 	"\n" +
@@ -686,39 +734,42 @@ func file_userservice_v1_users_proto_rawDescGZIP() []byte {
 	return file_userservice_v1_users_proto_rawDescData
 }
 
-var file_userservice_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_userservice_v1_users_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_userservice_v1_users_proto_goTypes = []any{
 	(*User)(nil),                  // 0: users.v1.User
 	(*CreateUserRequest)(nil),     // 1: users.v1.CreateUserRequest
 	(*UserReply)(nil),             // 2: users.v1.UserReply
 	(*GetUserRequest)(nil),        // 3: users.v1.GetUserRequest
-	(*UpdateUserRequest)(nil),     // 4: users.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),     // 5: users.v1.DeleteUserRequest
-	(*DeleteUserReply)(nil),       // 6: users.v1.DeleteUserReply
-	(*ListUsersRequest)(nil),      // 7: users.v1.ListUsersRequest
-	(*ListUsersReply)(nil),        // 8: users.v1.ListUsersReply
-	(*LoginUserRequest)(nil),      // 9: users.v1.LoginUserRequest
-	(*AuthReply)(nil),             // 10: users.v1.AuthReply
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*GetUserByEmailRequest)(nil), // 4: users.v1.GetUserByEmailRequest
+	(*UpdateUserRequest)(nil),     // 5: users.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),     // 6: users.v1.DeleteUserRequest
+	(*DeleteUserReply)(nil),       // 7: users.v1.DeleteUserReply
+	(*ListUsersRequest)(nil),      // 8: users.v1.ListUsersRequest
+	(*ListUsersReply)(nil),        // 9: users.v1.ListUsersReply
+	(*LoginUserRequest)(nil),      // 10: users.v1.LoginUserRequest
+	(*AuthReply)(nil),             // 11: users.v1.AuthReply
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
 }
 var file_userservice_v1_users_proto_depIdxs = []int32{
-	11, // 0: users.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	12, // 0: users.v1.User.created_at:type_name -> google.protobuf.Timestamp
 	0,  // 1: users.v1.ListUsersReply.users:type_name -> users.v1.User
 	0,  // 2: users.v1.AuthReply.user:type_name -> users.v1.User
 	1,  // 3: users.v1.UserService.CreateUser:input_type -> users.v1.CreateUserRequest
 	3,  // 4: users.v1.UserService.GetUser:input_type -> users.v1.GetUserRequest
-	4,  // 5: users.v1.UserService.UpdateUser:input_type -> users.v1.UpdateUserRequest
-	5,  // 6: users.v1.UserService.DeleteUser:input_type -> users.v1.DeleteUserRequest
-	7,  // 7: users.v1.UserService.ListUsers:input_type -> users.v1.ListUsersRequest
-	9,  // 8: users.v1.UserService.LoginUser:input_type -> users.v1.LoginUserRequest
-	2,  // 9: users.v1.UserService.CreateUser:output_type -> users.v1.UserReply
-	2,  // 10: users.v1.UserService.GetUser:output_type -> users.v1.UserReply
-	2,  // 11: users.v1.UserService.UpdateUser:output_type -> users.v1.UserReply
-	6,  // 12: users.v1.UserService.DeleteUser:output_type -> users.v1.DeleteUserReply
-	8,  // 13: users.v1.UserService.ListUsers:output_type -> users.v1.ListUsersReply
-	10, // 14: users.v1.UserService.LoginUser:output_type -> users.v1.AuthReply
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
+	4,  // 5: users.v1.UserService.GetUserByEmail:input_type -> users.v1.GetUserByEmailRequest
+	5,  // 6: users.v1.UserService.UpdateUser:input_type -> users.v1.UpdateUserRequest
+	6,  // 7: users.v1.UserService.DeleteUser:input_type -> users.v1.DeleteUserRequest
+	8,  // 8: users.v1.UserService.ListUsers:input_type -> users.v1.ListUsersRequest
+	10, // 9: users.v1.UserService.LoginUser:input_type -> users.v1.LoginUserRequest
+	2,  // 10: users.v1.UserService.CreateUser:output_type -> users.v1.UserReply
+	2,  // 11: users.v1.UserService.GetUser:output_type -> users.v1.UserReply
+	2,  // 12: users.v1.UserService.GetUserByEmail:output_type -> users.v1.UserReply
+	2,  // 13: users.v1.UserService.UpdateUser:output_type -> users.v1.UserReply
+	7,  // 14: users.v1.UserService.DeleteUser:output_type -> users.v1.DeleteUserReply
+	9,  // 15: users.v1.UserService.ListUsers:output_type -> users.v1.ListUsersReply
+	11, // 16: users.v1.UserService.LoginUser:output_type -> users.v1.AuthReply
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -729,14 +780,14 @@ func file_userservice_v1_users_proto_init() {
 	if File_userservice_v1_users_proto != nil {
 		return
 	}
-	file_userservice_v1_users_proto_msgTypes[4].OneofWrappers = []any{}
+	file_userservice_v1_users_proto_msgTypes[5].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_userservice_v1_users_proto_rawDesc), len(file_userservice_v1_users_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
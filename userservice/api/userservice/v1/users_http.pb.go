@@ -22,6 +22,7 @@ const _ = http.SupportPackageIsVersion1
 const OperationUserServiceCreateUser = "/users.v1.UserService/CreateUser"
 const OperationUserServiceDeleteUser = "/users.v1.UserService/DeleteUser"
 const OperationUserServiceGetUser = "/users.v1.UserService/GetUser"
+const OperationUserServiceGetUserByEmail = "/users.v1.UserService/GetUserByEmail"
 const OperationUserServiceListUsers = "/users.v1.UserService/ListUsers"
 const OperationUserServiceLoginUser = "/users.v1.UserService/LoginUser"
 const OperationUserServiceUpdateUser = "/users.v1.UserService/UpdateUser"
@@ -33,6 +34,8 @@ type UserServiceHTTPServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserReply, error)
 	// GetUser Get a user by ID
 	GetUser(context.Context, *GetUserRequest) (*UserReply, error)
+	// GetUserByEmail Get a user by email
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserReply, error)
 	// ListUsers List all users
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
 	LoginUser(context.Context, *LoginUserRequest) (*AuthReply, error)
@@ -44,6 +47,7 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/users", _UserService_CreateUser0_HTTP_Handler(srv))
 	r.GET("/users/{id}", _UserService_GetUser0_HTTP_Handler(srv))
+	r.GET("/users/by-email", _UserService_GetUserByEmail0_HTTP_Handler(srv))
 	r.PUT("/users/{id}", _UserService_UpdateUser0_HTTP_Handler(srv))
 	r.DELETE("/users/{id}", _UserService_DeleteUser0_HTTP_Handler(srv))
 	r.GET("/users", _UserService_ListUsers0_HTTP_Handler(srv))
@@ -94,6 +98,25 @@ func _UserService_GetUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
+func _UserService_GetUserByEmail0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserByEmailRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceGetUserByEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserService_UpdateUser0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UpdateUserRequest
@@ -189,6 +212,8 @@ type UserServiceHTTPClient interface {
 	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserReply, err error)
 	// GetUser Get a user by ID
 	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *UserReply, err error)
+	// GetUserByEmail Get a user by email
+	GetUserByEmail(ctx context.Context, req *GetUserByEmailRequest, opts ...http.CallOption) (rsp *UserReply, err error)
 	// ListUsers List all users
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
 	LoginUser(ctx context.Context, req *LoginUserRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
@@ -246,6 +271,20 @@ func (c *UserServiceHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequ
 	return &out, nil
 }
 
+// GetUserByEmail Get a user by email
+func (c *UserServiceHTTPClientImpl) GetUserByEmail(ctx context.Context, in *GetUserByEmailRequest, opts ...http.CallOption) (*UserReply, error) {
+	var out UserReply
+	pattern := "/users/by-email"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserServiceGetUserByEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListUsers List all users
 func (c *UserServiceHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersReply, error) {
 	var out ListUsersReply
@@ -56,10 +56,11 @@ func (uc *UserUsecase) Login(ctx context.Context, req *v1.LoginUserRequest) (*v1
 		return nil, errors.New("invalid credentials")
 	}
 
-	// JWT claims
+	// JWT claims; bookingservice checks the role on staff and admin operations
 	claims := jwt.MapClaims{
 		"user_id": user.Id,
 		"email":   user.Email,
+		"role":    user.Role,
 		"exp":     time.Now().Add(time.Hour * 24).Unix(), // token expires in 24h
 	}
 
@@ -80,6 +81,10 @@ func (uc *UserUsecase) Get(ctx context.Context, id uint64) (*v1.User, error) {
 	return uc.repo.Get(ctx, id)
 }
 
+func (uc *UserUsecase) GetByEmail(ctx context.Context, email string) (*v1.User, error) {
+	return uc.repo.GetByEmail(ctx, email)
+}
+
 func (uc *UserUsecase) Update(ctx context.Context, req *v1.UpdateUserRequest) (*v1.User, error) {
 	// re-hash password if provided
 	if req.Password != nil && *req.Password != "" {
@@ -17,6 +17,7 @@ type User struct {
 	Name         string
 	Email        string    `gorm:"uniqueIndex"`
 	PasswordHash string
+	Role         string    `gorm:"not null;default:''"` // staff or admin; empty for customers
 	CreatedAt    time.Time
 }
 
@@ -111,6 +112,7 @@ func toProto(u *User) *v1.User {
 		Name:         u.Name,
 		Email:        u.Email,
 		PasswordHash: u.PasswordHash,
+		Role:         u.Role,
 		CreatedAt:    timestamppb.New(u.CreatedAt),
 	}
 }
@@ -39,6 +39,18 @@ func (s *UserService) GetUser(ctx context.Context, req *v1.GetUserRequest) (*v1.
     }, nil
 }
 
+func (s *UserService) GetUserByEmail(ctx context.Context, req *v1.GetUserByEmailRequest) (*v1.UserReply, error) {
+    user, err := s.uc.GetByEmail(ctx, req.Email)
+    if err != nil {
+        return nil, err
+    }
+    return &v1.UserReply{
+        Id:    user.Id,
+        Name:  user.Name,
+        Email: user.Email,
+    }, nil
+}
+
 func (s *UserService) UpdateUser(ctx context.Context, req *v1.UpdateUserRequest) (*v1.UserReply, error) {
     user, err := s.uc.Update(ctx, req)
     if err != nil {